@@ -0,0 +1,179 @@
+package bynom
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// fixedLen reads n bytes from the plate and passes them to fn.
+// The bytes are obtained with Plate.ByteSlice, so they are not copied.
+// If the plate has less than n bytes left the parser fails with io.ErrUnexpectedEOF.
+func fixedLen(funcName string, n int, fn func([]byte)) Nom {
+	return func(ctx context.Context, p Plate) (err error) {
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		for i := 0; i < n; i++ {
+			if _, err = p.NextByte(ctx); err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, startPos+i)
+			}
+		}
+
+		var b []byte
+		if b, err = p.ByteSlice(ctx, startPos, startPos+n); err != nil {
+			return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, startPos+n)
+		}
+		fn(b)
+
+		return
+	}
+}
+
+// U8 reads one byte from the plate and assigns it to the variable p.
+func U8(p *uint8) Nom {
+	return fixedLen("U8", 1, func(b []byte) {
+		*p = b[0]
+	})
+}
+
+// I8 reads one byte from the plate and assigns it as a signed integer to the variable p.
+func I8(p *int8) Nom {
+	return fixedLen("I8", 1, func(b []byte) {
+		*p = int8(b[0])
+	})
+}
+
+// U16BE reads 2 bytes from the plate as a big-endian unsigned integer and assigns it to the variable p.
+// If the plate has less than 2 bytes left the function fails with io.ErrUnexpectedEOF.
+func U16BE(p *uint16) Nom {
+	return fixedLen("U16BE", 2, func(b []byte) {
+		*p = binary.BigEndian.Uint16(b)
+	})
+}
+
+// U16LE reads 2 bytes from the plate as a little-endian unsigned integer and assigns it to the variable p.
+// If the plate has less than 2 bytes left the function fails with io.ErrUnexpectedEOF.
+func U16LE(p *uint16) Nom {
+	return fixedLen("U16LE", 2, func(b []byte) {
+		*p = binary.LittleEndian.Uint16(b)
+	})
+}
+
+// I16BE reads 2 bytes from the plate as a big-endian signed integer and assigns it to the variable p.
+// If the plate has less than 2 bytes left the function fails with io.ErrUnexpectedEOF.
+func I16BE(p *int16) Nom {
+	return fixedLen("I16BE", 2, func(b []byte) {
+		*p = int16(binary.BigEndian.Uint16(b))
+	})
+}
+
+// I16LE reads 2 bytes from the plate as a little-endian signed integer and assigns it to the variable p.
+// If the plate has less than 2 bytes left the function fails with io.ErrUnexpectedEOF.
+func I16LE(p *int16) Nom {
+	return fixedLen("I16LE", 2, func(b []byte) {
+		*p = int16(binary.LittleEndian.Uint16(b))
+	})
+}
+
+// U32BE reads 4 bytes from the plate as a big-endian unsigned integer and assigns it to the variable p.
+// If the plate has less than 4 bytes left the function fails with io.ErrUnexpectedEOF.
+func U32BE(p *uint32) Nom {
+	return fixedLen("U32BE", 4, func(b []byte) {
+		*p = binary.BigEndian.Uint32(b)
+	})
+}
+
+// U32LE reads 4 bytes from the plate as a little-endian unsigned integer and assigns it to the variable p.
+// If the plate has less than 4 bytes left the function fails with io.ErrUnexpectedEOF.
+func U32LE(p *uint32) Nom {
+	return fixedLen("U32LE", 4, func(b []byte) {
+		*p = binary.LittleEndian.Uint32(b)
+	})
+}
+
+// I32BE reads 4 bytes from the plate as a big-endian signed integer and assigns it to the variable p.
+// If the plate has less than 4 bytes left the function fails with io.ErrUnexpectedEOF.
+func I32BE(p *int32) Nom {
+	return fixedLen("I32BE", 4, func(b []byte) {
+		*p = int32(binary.BigEndian.Uint32(b))
+	})
+}
+
+// I32LE reads 4 bytes from the plate as a little-endian signed integer and assigns it to the variable p.
+// If the plate has less than 4 bytes left the function fails with io.ErrUnexpectedEOF.
+func I32LE(p *int32) Nom {
+	return fixedLen("I32LE", 4, func(b []byte) {
+		*p = int32(binary.LittleEndian.Uint32(b))
+	})
+}
+
+// U64BE reads 8 bytes from the plate as a big-endian unsigned integer and assigns it to the variable p.
+// If the plate has less than 8 bytes left the function fails with io.ErrUnexpectedEOF.
+func U64BE(p *uint64) Nom {
+	return fixedLen("U64BE", 8, func(b []byte) {
+		*p = binary.BigEndian.Uint64(b)
+	})
+}
+
+// U64LE reads 8 bytes from the plate as a little-endian unsigned integer and assigns it to the variable p.
+// If the plate has less than 8 bytes left the function fails with io.ErrUnexpectedEOF.
+func U64LE(p *uint64) Nom {
+	return fixedLen("U64LE", 8, func(b []byte) {
+		*p = binary.LittleEndian.Uint64(b)
+	})
+}
+
+// I64BE reads 8 bytes from the plate as a big-endian signed integer and assigns it to the variable p.
+// If the plate has less than 8 bytes left the function fails with io.ErrUnexpectedEOF.
+func I64BE(p *int64) Nom {
+	return fixedLen("I64BE", 8, func(b []byte) {
+		*p = int64(binary.BigEndian.Uint64(b))
+	})
+}
+
+// I64LE reads 8 bytes from the plate as a little-endian signed integer and assigns it to the variable p.
+// If the plate has less than 8 bytes left the function fails with io.ErrUnexpectedEOF.
+func I64LE(p *int64) Nom {
+	return fixedLen("I64LE", 8, func(b []byte) {
+		*p = int64(binary.LittleEndian.Uint64(b))
+	})
+}
+
+// F32BE reads 4 bytes from the plate as a big-endian IEEE 754 floating-point number and assigns it to the variable p.
+// If the plate has less than 4 bytes left the function fails with io.ErrUnexpectedEOF.
+func F32BE(p *float32) Nom {
+	return fixedLen("F32BE", 4, func(b []byte) {
+		*p = math.Float32frombits(binary.BigEndian.Uint32(b))
+	})
+}
+
+// F32LE reads 4 bytes from the plate as a little-endian IEEE 754 floating-point number and assigns it to the variable p.
+// If the plate has less than 4 bytes left the function fails with io.ErrUnexpectedEOF.
+func F32LE(p *float32) Nom {
+	return fixedLen("F32LE", 4, func(b []byte) {
+		*p = math.Float32frombits(binary.LittleEndian.Uint32(b))
+	})
+}
+
+// F64BE reads 8 bytes from the plate as a big-endian IEEE 754 floating-point number and assigns it to the variable p.
+// If the plate has less than 8 bytes left the function fails with io.ErrUnexpectedEOF.
+func F64BE(p *float64) Nom {
+	return fixedLen("F64BE", 8, func(b []byte) {
+		*p = math.Float64frombits(binary.BigEndian.Uint64(b))
+	})
+}
+
+// F64LE reads 8 bytes from the plate as a little-endian IEEE 754 floating-point number and assigns it to the variable p.
+// If the plate has less than 8 bytes left the function fails with io.ErrUnexpectedEOF.
+func F64LE(p *float64) Nom {
+	return fixedLen("F64LE", 8, func(b []byte) {
+		*p = math.Float64frombits(binary.LittleEndian.Uint64(b))
+	})
+}
@@ -2,10 +2,13 @@ package bynom
 
 import (
 	"context"
+	"errors"
 )
 
 const DefaultParseContextLen = 100
 
+type eatKey struct{}
+
 // Bite composes multiple parsing functions into complex parsing logic.
 // Bite implements Eater interface.
 type Bite struct {
@@ -25,12 +28,29 @@ func NewBite(noms ...Nom) *Bite {
 // Eat parses the next piece on the Plate p.
 // Parsing is performed in transactional manner, if at least one parser fails the read position
 // in the Plate p will be reverted to the position it was when Eat started.
+// Outcomes of parsers made with Memo are cached during one call of Eat.
+// If all parsers succeeded and the Plate p implements Committer the bytes parsed are committed.
+// Eat called by parsers of another Eat, e.g. when Bite is used as Nom or Parse is called inside a grammar,
+// does not commit, because the outer Eat may still revert the read position.
+// If the Plate p implements Locator the error returned contains the line and column where parsing failed.
+// If parsing stopped because the Plate p run out of buffered bytes the function returns ErrNeedMore,
+// so Eat can be called again when more bytes are available.
+// If parsers made with Recover recovered from failures the function reverts the read position
+// and returns ErrParseFailures containing all failures.
 func (bite *Bite) Eat(ctx context.Context, p Plate) (err error) {
 	var startPos int
 	if startPos, err = p.TellPosition(ctx); err != nil {
 		return
 	}
 
+	var outermost = ctx.Value(eatKey{}) == nil
+	if outermost {
+		ctx = context.WithValue(ctx, eatKey{}, true)
+	}
+
+	var rec = &recovery{bite: bite}
+	ctx = withRecovery(withRecursion(withMemoCache(ctx, p)), rec)
+
 	var errPos int
 	for _, nom := range bite.noms {
 		if err = nom(ctx, p); err != nil {
@@ -40,24 +60,60 @@ func (bite *Bite) Eat(ctx context.Context, p Plate) (err error) {
 		}
 	}
 
-	if err != nil && !bite.DisableParseContext {
-		var ctxLen = bite.ParseContextLen
-		if ctxLen == 0 {
-			ctxLen = DefaultParseContextLen
-		} else if ctxLen < 0 {
-			ctxLen = errPos - startPos
-		}
+	if err != nil && errors.Is(err, ErrNeedMore) {
+		return ErrNeedMore
+	}
+
+	if err != nil {
+		err = bite.parseFailed(ctx, p, err, startPos, errPos)
+	}
 
-		var e = &ErrParseFailed{
-			Err:      err,
-			StartPos: startPos,
-			EndPos:   errPos,
+	if len(rec.errs) > 0 {
+		if err == nil {
+			_ = p.SeekPosition(ctx, startPos)
+		} else {
+			rec.errs = append(rec.errs, err)
+		}
+		return &ErrParseFailures{
+			Errs: rec.errs,
 		}
-		e.CopyContext(ctx, p, startPos, errPos, ctxLen)
-		e.UnwrapBreadcrumbs()
+	}
 
-		return e
+	if err == nil && outermost {
+		if c, ok := p.(Committer); ok {
+			var endPos int
+			if endPos, err = p.TellPosition(ctx); err != nil {
+				return
+			}
+			err = c.Commit(ctx, endPos)
+		}
 	}
 
 	return
 }
+
+// parseFailed makes the error describing the failure err of parsing which started
+// at the position startPos and failed at the position errPos.
+func (bite *Bite) parseFailed(ctx context.Context, p Plate, err error, startPos, errPos int) error {
+	if bite.DisableParseContext {
+		return err
+	}
+
+	var ctxLen = bite.ParseContextLen
+	if ctxLen == 0 {
+		ctxLen = DefaultParseContextLen
+	} else if ctxLen < 0 {
+		ctxLen = errPos - startPos
+	}
+
+	var e = &ErrParseFailed{
+		Err:      err,
+		StartPos: startPos,
+		EndPos:   errPos,
+	}
+	e.CopyContext(ctx, p, startPos, errPos, ctxLen)
+	e.CopyLocation(ctx, p, errPos)
+	e.UnwrapBreadcrumbs()
+
+	return e
+}
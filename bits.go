@@ -0,0 +1,142 @@
+package bynom
+
+import (
+	"context"
+	"io"
+)
+
+// BitOrder defines the order bits are read from bytes.
+type BitOrder int
+
+const (
+	// MSBFirst reads bits from the most significant bit of each byte, the first bit read
+	// is the most significant bit of the value. It is the order of network protocol headers.
+	MSBFirst BitOrder = iota
+	// LSBFirst reads bits from the least significant bit of each byte, the first bit read
+	// is the least significant bit of the value. It is the order of e.g. DEFLATE.
+	LSBFirst
+)
+
+// BitNom parses bits read with the bit reader br.
+type BitNom func(ctx context.Context, br *BitReader) error
+
+// BitReader reads bits from the plate in the bit order. Bytes are read from the plate on demand,
+// so the byte containing the next bit is already consumed from the plate.
+type BitReader struct {
+	p     Plate
+	order BitOrder
+	cur   byte // The byte bits are read from.
+	left  int  // The amount of bits left unread in cur.
+}
+
+// ReadBits reads n bits, up to 64, and returns them as an unsigned integer.
+// If the plate has not enough bytes left the function fails with io.ErrUnexpectedEOF.
+func (br *BitReader) ReadBits(ctx context.Context, n int) (v uint64, err error) {
+	if n < 0 || n > 64 {
+		return 0, errInvalidBitCount
+	}
+
+	var shift int
+	for n > 0 {
+		if br.left == 0 {
+			if br.cur, err = br.p.NextByte(ctx); err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				return 0, err
+			}
+			br.left = 8
+		}
+
+		var k = n
+		if k > br.left {
+			k = br.left
+		}
+		var mask = uint64(1)<<k - 1
+
+		if br.order == LSBFirst {
+			v |= (uint64(br.cur>>(8-br.left)) & mask) << shift
+			shift += k
+		} else {
+			v = v<<k | uint64(br.cur>>(br.left-k))&mask
+		}
+		br.left -= k
+		n -= k
+	}
+
+	return
+}
+
+// Aligned tells if the bit reader is at the byte boundary.
+func (br *BitReader) Aligned() bool {
+	return br.left == 0
+}
+
+// Bits runs all bit parsers noms reading bits from the plate in the bit order order.
+// When all noms finished the bits left unread in the last byte are skipped, so the plate is realigned to bytes.
+func Bits(order BitOrder, noms ...BitNom) Nom {
+	const funcName = "Bits"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var br = &BitReader{
+			p:     p,
+			order: order,
+		}
+
+		for i, nom := range noms {
+			var nomStartPos int
+			if nomStartPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, i)
+			}
+
+			if err = nom(ctx, br); err != nil {
+				var nomErrPos, _ = p.TellPosition(ctx)
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, i), nomStartPos, nomErrPos)
+			}
+		}
+
+		return
+	}
+}
+
+// TakeBits reads n bits, up to 64, and assigns them to the variable v.
+func TakeBits(n int, v *uint64) BitNom {
+	const funcName = "TakeBits"
+
+	return func(ctx context.Context, br *BitReader) (err error) {
+		var bits uint64
+		if bits, err = br.ReadBits(ctx, n); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		*v = bits
+		return
+	}
+}
+
+// ExpectBits reads n bits, up to 64, and expects them to be equal value.
+// If bits do not equal value the function will return ErrBitsExpectationFailed.
+func ExpectBits(n int, value uint64) BitNom {
+	const funcName = "ExpectBits"
+
+	return func(ctx context.Context, br *BitReader) (err error) {
+		var bits uint64
+		if bits, err = br.ReadBits(ctx, n); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		if bits != value {
+			return WrapBreadcrumb(
+				ErrBitsExpectationFailed{
+					Len:      n,
+					Expected: value,
+					Have:     bits,
+				},
+				funcName,
+				-1,
+			)
+		}
+
+		return
+	}
+}
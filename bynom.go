@@ -25,16 +25,39 @@ type Plate interface {
 
 	// SeekPosition sets the new read position.
 	// Seek is done from the start, in terms of io package it is Seek(0, io.SeekStart).
+	// The position can be set to the end of the byte sequence, i.e. to its length, so parsers
+	// which consumed all bytes can be rolled back to that position. After that NextByte returns io.EOF.
 	SeekPosition(context.Context, int) error
 }
 
 // Feeder feeds bytes from plate to parser.
 // Plate implementation can support transactional parsing by implementing Feeder.
+// See Feast for how to drive parsing with Feeder.
 type Feeder interface {
 	// Feed feeds the parser the next portion of bytes.
+	// The function returns the error the parser finished with.
+	//
+	// When no portions left the function returns io.EOF.
 	Feed(context.Context, Nom) error
 }
 
+// Committer allows plate to release bytes which will never be read again.
+// Plate implementation can keep memory consumption bounded by implementing Committer.
+type Committer interface {
+	// Commit notifies the plate that bytes before the position pos will never be read again.
+	// After successful call to Commit the read position can not be set to a position before pos
+	// and slices which start before pos can not be obtained.
+	Commit(context.Context, int) error
+}
+
+// Locator maps read positions of plate to text locations.
+// Plate implementation can provide human friendly error locations by implementing Locator.
+type Locator interface {
+	// LocatePosition returns 1-based line and column numbers of the byte at the position pos.
+	// Lines are separated with '\n' and columns are counted in bytes.
+	LocatePosition(context.Context, int) (line int, column int, err error)
+}
+
 // Eater parses bytes on plate according to inner logic.
 type Eater interface {
 	// Eat parses the next portion of bytes from the Plate.
@@ -0,0 +1,55 @@
+package bynom
+
+import (
+	"context"
+	"errors"
+)
+
+// Cut runs all parsers noms until all finished or at least one failed.
+// If a parser fails the function fails with ErrCut wrapping that error. It makes the failure fatal,
+// so Switch, Optional, WhenNot, Not and repetition combinators do not try alternatives and do not swallow it.
+// Cut is used after a parser which has clearly recognized the construction, so the error points
+// at the real problem instead of the last alternative tried.
+func Cut(noms ...Nom) Nom {
+	const funcName = "Cut"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		for i, nom := range noms {
+			var nomStartPos int
+			if nomStartPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, i)
+			}
+
+			if err = nom(ctx, p); err != nil {
+				if !isFatal(err) {
+					err = &ErrCut{Err: err}
+				}
+				var nomErrPos, _ = p.TellPosition(ctx)
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, i), nomStartPos, nomErrPos)
+			}
+		}
+
+		return
+	}
+}
+
+// isFatal tests if the error err must not be recovered by trying alternatives.
+func isFatal(err error) bool {
+	var cut *ErrCut
+	if errors.As(err, &cut) {
+		return true
+	}
+
+	return isUnrecoverable(err)
+}
+
+// isUnrecoverable tests if the error err must not be recovered even by Recover,
+// i.e. the plate run out of buffered bytes or the recursion is too deep.
+func isUnrecoverable(err error) bool {
+	if errors.Is(err, ErrNeedMore) {
+		return true
+	}
+
+	var depth ErrMaxDepthExceeded
+	return errors.As(err, &depth)
+}
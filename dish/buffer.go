@@ -0,0 +1,130 @@
+package dish
+
+import (
+	"context"
+	"io"
+
+	"github.com/workanator/bynom"
+)
+
+// Buffer implements bynom.Plate interface over bytes pushed into it with Write.
+// When the buffered bytes run out and Buffer is not closed yet reading functions
+// return bynom.ErrNeedMore, so the parsing can be resumed when more bytes written.
+// Close marks the end of the input, after that reading functions return io.EOF
+// when the buffered bytes run out.
+// Buffer implements bynom.Committer so bytes before the committed position are released.
+//
+// Slices returned by ByteSlice alias the internal buffer of the Buffer.
+type Buffer struct {
+	buf    []byte
+	offset int // The position of the first byte in buf.
+	pos    int
+	closed bool
+}
+
+// NewBuffer makes a new empty Buffer instance.
+func NewBuffer() *Buffer {
+	return &Buffer{}
+}
+
+// Write appends bytes p to the buffer.
+func (bb *Buffer) Write(p []byte) (n int, err error) {
+	if bb.closed {
+		return 0, errPlateClosed
+	}
+
+	bb.buf = append(bb.buf, p...)
+	return len(p), nil
+}
+
+// Close marks the end of the input.
+func (bb *Buffer) Close() error {
+	bb.closed = true
+	return nil
+}
+
+// NextByte reads the next byte from the buffer.
+func (bb *Buffer) NextByte(context.Context) (b byte, err error) {
+	if bb.pos >= bb.end() {
+		return 0, bb.errEnd()
+	}
+
+	b = bb.buf[bb.pos-bb.offset]
+	bb.pos++
+	return
+}
+
+// PeekByte returns the current byte in the buffer.
+func (bb *Buffer) PeekByte(context.Context) (b byte, err error) {
+	if bb.pos >= bb.end() {
+		return 0, bb.errEnd()
+	}
+
+	return bb.buf[bb.pos-bb.offset], nil
+}
+
+// ByteSlice returns the slice of the buffer.
+func (bb *Buffer) ByteSlice(_ context.Context, start int, end int) (p []byte, err error) {
+	if end < start {
+		return nil, errStartLessEnd
+	}
+	if end > bb.end() && !bb.closed {
+		return nil, bynom.ErrNeedMore
+	}
+	if start < bb.offset || start >= bb.end() {
+		return nil, errPositionOufOfBound
+	}
+	if end < bb.offset || end > bb.end() {
+		return nil, errPositionOufOfBound
+	}
+
+	return bb.buf[start-bb.offset : end-bb.offset], nil
+}
+
+// TellPosition returns the current read position.
+func (bb *Buffer) TellPosition(context.Context) (pos int, err error) {
+	return bb.pos, nil
+}
+
+// SeekPosition sets the new read position.
+// The position can be set to the end of the buffered bytes and can not be set before the position committed.
+func (bb *Buffer) SeekPosition(_ context.Context, pos int) (err error) {
+	if pos > bb.end() && !bb.closed {
+		return bynom.ErrNeedMore
+	}
+	if pos >= bb.offset && pos <= bb.end() {
+		bb.pos = pos
+		return nil
+	}
+	return errPositionOufOfBound
+}
+
+// Commit releases all bytes before the position pos.
+// The position pos can not be beyond the current read position.
+func (bb *Buffer) Commit(_ context.Context, pos int) (err error) {
+	if pos > bb.pos {
+		return errPositionOufOfBound
+	}
+	if pos <= bb.offset {
+		return nil
+	}
+
+	// The released bytes are not overwritten, so slices obtained before remain valid.
+	// The memory is reclaimed when Write reallocates the buffer.
+	bb.buf = bb.buf[pos-bb.offset:]
+	bb.offset = pos
+	return
+}
+
+// end returns the position next to the last byte buffered.
+func (bb *Buffer) end() int {
+	return bb.offset + len(bb.buf)
+}
+
+// errEnd returns the error reading functions fail with when the buffered bytes run out.
+func (bb *Buffer) errEnd() error {
+	if bb.closed {
+		return io.EOF
+	}
+	return bynom.ErrNeedMore
+}
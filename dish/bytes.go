@@ -61,15 +61,22 @@ func (bd *Bytes) TellPosition(context.Context) (pos int, err error) {
 }
 
 // SeekPosition sets the new read position.
+// The position can be set to the end of the slice.
 func (bd *Bytes) SeekPosition(_ context.Context, pos int) (err error) {
-	if pos >= 0 && pos < len(bd.buf) {
+	if pos >= 0 && pos <= len(bd.buf) {
 		bd.pos = pos
 		return nil
 	}
 	return errPositionOufOfBound
 }
 
+// Commit does nothing because the whole slice stays in memory.
+func (bd *Bytes) Commit(context.Context, int) error {
+	return nil
+}
+
 var (
 	errPositionOufOfBound = errors.New("position out of bounds")
 	errStartLessEnd       = errors.New("start position less than end position")
+	errPlateClosed        = errors.New("plate closed")
 )
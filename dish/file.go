@@ -0,0 +1,214 @@
+package dish
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+)
+
+// DefaultFileWindowLen is the amount of bytes File reads at once when the file is not memory-mapped.
+const DefaultFileWindowLen = 64 * 1024
+
+const maxInt = int(^uint(0) >> 1)
+
+// File implements bynom.Plate interface over a read-only file or io.ReaderAt.
+// Files opened with OpenFile are memory-mapped on Linux and ByteSlice returns slices
+// of the mapping without copying. Otherwise bytes are read with io.ReaderAt by windows
+// of DefaultFileWindowLen bytes, or the size given to NewReaderAtSize, and ByteSlice copies only ranges which cross the window boundary.
+//
+// Slices returned by ByteSlice are valid until Close is called.
+type File struct {
+	ra     io.ReaderAt
+	closer io.Closer
+	data   []byte // The memory-mapped content of the file, nil if the file is not mapped.
+	size   int
+	pos    int
+	win    []byte // The window of bytes read with ReadAt.
+	winPos int    // The position of the first byte in win, it is multiple of winLen.
+	winLen int    // The amount of bytes read with ReadAt at once.
+	shared bool   // ByteSlice returned the slice of win, so win can not be reused.
+}
+
+// OpenFile opens the file with the name for reading and makes a new File instance from it.
+// The file is memory-mapped if the system supports it.
+func OpenFile(name string) (fd *File, err error) {
+	var f *os.File
+	if f, err = os.Open(name); err != nil {
+		return
+	}
+
+	var fi os.FileInfo
+	if fi, err = f.Stat(); err != nil {
+		_ = f.Close()
+		return
+	}
+	if fi.Size() > int64(maxInt) {
+		_ = f.Close()
+		return nil, errFileTooLarge
+	}
+
+	fd = &File{
+		ra:     f,
+		closer: f,
+		size:   int(fi.Size()),
+		winLen: DefaultFileWindowLen,
+	}
+	if fd.size > 0 {
+		// Fall back to io.ReaderAt if the file can not be mapped.
+		fd.data, _ = mmap(f, fd.size)
+	}
+
+	return
+}
+
+// NewReaderAt makes a new File instance which reads size bytes from ra.
+func NewReaderAt(ra io.ReaderAt, size int64) (*File, error) {
+	return NewReaderAtSize(ra, size, DefaultFileWindowLen)
+}
+
+// NewReaderAtSize makes a new File instance which reads size bytes from ra
+// by windows of windowLen bytes.
+func NewReaderAtSize(ra io.ReaderAt, size int64, windowLen int) (*File, error) {
+	if size < 0 || size > int64(maxInt) {
+		return nil, errFileTooLarge
+	}
+	if windowLen <= 0 {
+		windowLen = DefaultFileWindowLen
+	}
+
+	return &File{
+		ra:     ra,
+		size:   int(size),
+		winLen: windowLen,
+	}, nil
+}
+
+// Close releases the memory mapping and closes the file.
+func (fd *File) Close() (err error) {
+	if fd.data != nil {
+		err = munmap(fd.data)
+		fd.data = nil
+	}
+	if fd.closer != nil {
+		if closeErr := fd.closer.Close(); err == nil {
+			err = closeErr
+		}
+		fd.closer = nil
+	}
+	fd.ra = nil
+	fd.win = nil
+
+	return
+}
+
+// NextByte reads the next byte from the file.
+func (fd *File) NextByte(context.Context) (b byte, err error) {
+	if fd.pos >= fd.size {
+		return 0, io.EOF
+	}
+
+	if b, err = fd.byteAt(fd.pos); err != nil {
+		return
+	}
+	fd.pos++
+	return
+}
+
+// PeekByte returns the current byte in the file.
+func (fd *File) PeekByte(context.Context) (b byte, err error) {
+	if fd.pos >= fd.size {
+		return 0, io.EOF
+	}
+
+	return fd.byteAt(fd.pos)
+}
+
+// ByteSlice returns the slice of the file.
+func (fd *File) ByteSlice(_ context.Context, start int, end int) (p []byte, err error) {
+	if end < start {
+		return nil, errStartLessEnd
+	}
+	if start < 0 || start >= fd.size {
+		return nil, errPositionOufOfBound
+	}
+	if end < 0 || end > fd.size {
+		return nil, errPositionOufOfBound
+	}
+
+	if fd.data != nil {
+		return fd.data[start:end], nil
+	}
+	if fd.ra == nil {
+		return nil, errPlateClosed
+	}
+	if start >= fd.winPos && end <= fd.winPos+len(fd.win) {
+		fd.shared = true
+		return fd.win[start-fd.winPos : end-fd.winPos], nil
+	}
+
+	p = make([]byte, end-start)
+	var n int
+	if n, err = fd.ra.ReadAt(p, int64(start)); n < len(p) {
+		if err == nil || err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// TellPosition returns the current read position.
+func (fd *File) TellPosition(context.Context) (pos int, err error) {
+	return fd.pos, nil
+}
+
+// SeekPosition sets the new read position.
+// The position can be set to the end of the file.
+func (fd *File) SeekPosition(_ context.Context, pos int) (err error) {
+	if pos >= 0 && pos <= fd.size {
+		fd.pos = pos
+		return nil
+	}
+	return errPositionOufOfBound
+}
+
+// byteAt returns the byte at the position pos reading the window which contains it if necessary.
+func (fd *File) byteAt(pos int) (b byte, err error) {
+	if fd.data != nil {
+		return fd.data[pos], nil
+	}
+	if fd.ra == nil {
+		return 0, errPlateClosed
+	}
+
+	if pos < fd.winPos || pos >= fd.winPos+len(fd.win) {
+		// The buffer of the window is reused unless ByteSlice returned its slice,
+		// so slices of previous windows remain valid.
+		var win = fd.win[:cap(fd.win)]
+		if fd.shared || len(win) < fd.winLen {
+			win = make([]byte, fd.winLen)
+			fd.shared = false
+		}
+
+		var (
+			winPos = pos - pos%fd.winLen
+			n      int
+		)
+		if n, err = fd.ra.ReadAt(win, int64(winPos)); n <= pos-winPos {
+			if err == nil || err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			fd.win = win[:0]
+			return
+		}
+		fd.win = win[:n]
+		fd.winPos = winPos
+		err = nil
+	}
+
+	return fd.win[pos-fd.winPos], nil
+}
+
+var errFileTooLarge = errors.New("file too large")
@@ -0,0 +1,19 @@
+//go:build linux
+// +build linux
+
+package dish
+
+import (
+	"os"
+	"syscall"
+)
+
+// mmap maps size bytes of the file f into memory for reading.
+func mmap(f *os.File, size int) ([]byte, error) {
+	return syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ, syscall.MAP_SHARED)
+}
+
+// munmap releases the memory mapping data.
+func munmap(data []byte) error {
+	return syscall.Munmap(data)
+}
@@ -0,0 +1,21 @@
+//go:build !linux
+// +build !linux
+
+package dish
+
+import (
+	"errors"
+	"os"
+)
+
+// mmap always fails because memory mapping is not supported on the system.
+func mmap(*os.File, int) ([]byte, error) {
+	return nil, errMmapUnsupported
+}
+
+// munmap does nothing because memory mapping is not supported on the system.
+func munmap([]byte) error {
+	return nil
+}
+
+var errMmapUnsupported = errors.New("memory mapping not supported")
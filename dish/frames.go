@@ -0,0 +1,42 @@
+package dish
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	"github.com/workanator/bynom"
+)
+
+// Frames splits a stream read from io.Reader into frames separated by the delimiter
+// and implements bynom.Feeder interface feeding parsers frame by frame.
+// Each frame is fed as a separate Bytes plate, so the failure of the parser on one frame
+// does not affect parsing of the next frames.
+type Frames struct {
+	r     *bufio.Reader
+	delim byte
+}
+
+// NewFrames makes a new Frames instance from the reader r which splits the stream
+// into frames separated by the byte delim.
+func NewFrames(r io.Reader, delim byte) *Frames {
+	return &Frames{
+		r:     bufio.NewReader(r),
+		delim: delim,
+	}
+}
+
+// Feed reads the next frame from the stream and feeds it to the parser nom.
+// The frame does not include the delimiter. The last frame may be not terminated with the delimiter.
+func (fr *Frames) Feed(ctx context.Context, nom bynom.Nom) (err error) {
+	var frame []byte
+	if frame, err = fr.r.ReadBytes(fr.delim); err != nil {
+		if err != io.EOF || len(frame) == 0 {
+			return
+		}
+	} else {
+		frame = frame[:len(frame)-1]
+	}
+
+	return nom(ctx, NewBytes(frame))
+}
@@ -0,0 +1,95 @@
+package dish
+
+import (
+	"context"
+	"sort"
+
+	"github.com/workanator/bynom"
+)
+
+// linesScanLen is the maximum amount of bytes Lines requests from the plate at once while searching for line breaks.
+const linesScanLen = 4096
+
+// Lines decorates a plate and implements bynom.Locator interface mapping read positions to
+// lines and columns. Lines are separated with '\n' and columns are counted in bytes.
+// Line breaks are searched lazily, only when a position is located or committed.
+type Lines struct {
+	bynom.Plate
+
+	newlines    []int // Positions of line breaks found and not released yet.
+	released    int   // The number of line breaks released.
+	lastNewline int   // The position of the last line break released, -1 if none.
+	scanned     int   // The position line breaks are searched before.
+}
+
+// NewLines makes a new Lines instance which decorates the plate p.
+func NewLines(p bynom.Plate) *Lines {
+	return &Lines{
+		Plate:       p,
+		lastNewline: -1,
+	}
+}
+
+// LocatePosition returns 1-based line and column numbers of the byte at the position pos.
+// The position can not be located if it is before the last line break committed.
+func (ld *Lines) LocatePosition(ctx context.Context, pos int) (line int, column int, err error) {
+	if pos < 0 || pos <= ld.lastNewline {
+		return 0, 0, errPositionOufOfBound
+	}
+	if err = ld.scan(ctx, pos); err != nil {
+		return
+	}
+
+	var (
+		n    = sort.SearchInts(ld.newlines, pos)
+		prev = ld.lastNewline
+	)
+	if n > 0 {
+		prev = ld.newlines[n-1]
+	}
+
+	return ld.released + n + 1, pos - prev, nil
+}
+
+// Commit releases line breaks before the position pos and commits the decorated plate
+// if it implements bynom.Committer.
+func (ld *Lines) Commit(ctx context.Context, pos int) (err error) {
+	if err = ld.scan(ctx, pos); err != nil {
+		return
+	}
+
+	if n := sort.SearchInts(ld.newlines, pos); n > 0 {
+		ld.lastNewline = ld.newlines[n-1]
+		ld.released += n
+		ld.newlines = ld.newlines[n:]
+	}
+
+	if c, ok := ld.Plate.(bynom.Committer); ok {
+		return c.Commit(ctx, pos)
+	}
+
+	return
+}
+
+// scan searches for line breaks before the position end.
+func (ld *Lines) scan(ctx context.Context, end int) (err error) {
+	for ld.scanned < end {
+		var chunkEnd = ld.scanned + linesScanLen
+		if chunkEnd > end {
+			chunkEnd = end
+		}
+
+		var p []byte
+		if p, err = ld.Plate.ByteSlice(ctx, ld.scanned, chunkEnd); err != nil {
+			return
+		}
+		for i, b := range p {
+			if b == '\n' {
+				ld.newlines = append(ld.newlines, ld.scanned+i)
+			}
+		}
+		ld.scanned = chunkEnd
+	}
+
+	return
+}
@@ -0,0 +1,158 @@
+package dish
+
+import (
+	"context"
+	"io"
+)
+
+// DefaultReaderChunkLen is the amount of bytes Reader requests from the underlying reader at once.
+const DefaultReaderChunkLen = 4096
+
+// Reader wraps an io.Reader and implements bynom.Plate interface
+// allowing traversing the stream without loading it into memory first.
+// Bytes are read from the underlying reader on demand and kept in the buffer
+// while they are reachable by SeekPosition. Reader implements bynom.Committer
+// so bytes before the committed position are released.
+//
+// Slices returned by ByteSlice alias the internal buffer of the Reader.
+type Reader struct {
+	r        io.Reader
+	buf      []byte
+	offset   int // The position of the first byte in buf.
+	pos      int
+	chunkLen int
+	err      error // The error the underlying reader finished with.
+}
+
+// NewReader makes a new Reader instance from the reader r.
+func NewReader(r io.Reader) *Reader {
+	return NewReaderSize(r, DefaultReaderChunkLen)
+}
+
+// NewReaderSize makes a new Reader instance from the reader r which reads
+// from r by chunks of size chunkLen.
+func NewReaderSize(r io.Reader, chunkLen int) *Reader {
+	if chunkLen <= 0 {
+		chunkLen = DefaultReaderChunkLen
+	}
+
+	return &Reader{
+		r:        r,
+		chunkLen: chunkLen,
+	}
+}
+
+// NextByte reads the next byte from the stream.
+func (rd *Reader) NextByte(ctx context.Context) (b byte, err error) {
+	if err = rd.fill(ctx, rd.pos+1); err != nil {
+		return
+	}
+	if rd.pos >= rd.end() {
+		return 0, io.EOF
+	}
+
+	b = rd.buf[rd.pos-rd.offset]
+	rd.pos++
+	return
+}
+
+// PeekByte returns the current byte in the stream.
+func (rd *Reader) PeekByte(ctx context.Context) (b byte, err error) {
+	if err = rd.fill(ctx, rd.pos+1); err != nil {
+		return
+	}
+	if rd.pos >= rd.end() {
+		return 0, io.EOF
+	}
+
+	return rd.buf[rd.pos-rd.offset], nil
+}
+
+// ByteSlice returns the slice of the stream.
+// The function reads from the stream if the range is not buffered yet.
+func (rd *Reader) ByteSlice(ctx context.Context, start int, end int) (p []byte, err error) {
+	if end < start {
+		return nil, errStartLessEnd
+	}
+	if err = rd.fill(ctx, end); err != nil {
+		return
+	}
+	if start < rd.offset || start >= rd.end() {
+		return nil, errPositionOufOfBound
+	}
+	if end < rd.offset || end > rd.end() {
+		return nil, errPositionOufOfBound
+	}
+
+	return rd.buf[start-rd.offset : end-rd.offset], nil
+}
+
+// TellPosition returns the current read position.
+func (rd *Reader) TellPosition(context.Context) (pos int, err error) {
+	return rd.pos, nil
+}
+
+// SeekPosition sets the new read position.
+// The position can be set to the end of the stream.
+// The function reads from the stream if the position is not buffered yet.
+// The position can not be set before the position committed.
+func (rd *Reader) SeekPosition(ctx context.Context, pos int) (err error) {
+	if err = rd.fill(ctx, pos); err != nil {
+		return
+	}
+	if pos >= rd.offset && pos <= rd.end() {
+		rd.pos = pos
+		return nil
+	}
+	return errPositionOufOfBound
+}
+
+// Commit releases all bytes before the position pos.
+// The position pos can not be beyond the current read position.
+func (rd *Reader) Commit(_ context.Context, pos int) (err error) {
+	if pos > rd.pos {
+		return errPositionOufOfBound
+	}
+	if pos <= rd.offset {
+		return nil
+	}
+
+	// The released bytes are not overwritten, so slices obtained before remain valid.
+	// The memory is reclaimed when fill reallocates the buffer.
+	rd.buf = rd.buf[pos-rd.offset:]
+	rd.offset = pos
+	return
+}
+
+// end returns the position next to the last byte buffered.
+func (rd *Reader) end() int {
+	return rd.offset + len(rd.buf)
+}
+
+// fill reads from the underlying reader until the buffer contains bytes up to the position end,
+// excluding the byte at the position end, or the underlying reader is exhausted.
+// The function returns non-nil error only if the underlying reader failed with error other than io.EOF
+// before the buffer was filled up to the position end.
+func (rd *Reader) fill(ctx context.Context, end int) (err error) {
+	for rd.end() < end && rd.err == nil {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		if cap(rd.buf)-len(rd.buf) < rd.chunkLen {
+			var buf = make([]byte, len(rd.buf), 2*len(rd.buf)+rd.chunkLen)
+			copy(buf, rd.buf)
+			rd.buf = buf
+		}
+
+		var n int
+		n, rd.err = rd.r.Read(rd.buf[len(rd.buf) : len(rd.buf)+rd.chunkLen])
+		rd.buf = rd.buf[:len(rd.buf)+n]
+	}
+
+	if rd.end() < end && rd.err != nil && rd.err != io.EOF {
+		return rd.err
+	}
+
+	return
+}
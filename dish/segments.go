@@ -0,0 +1,113 @@
+package dish
+
+import (
+	"context"
+	"io"
+	"sort"
+)
+
+// Segments wraps multiple byte slices and implements bynom.Plate interface
+// allowing traversing them as one continuous byte sequence.
+// ByteSlice returns the slice of the segment without copying if the range lies
+// within one segment and copies bytes only if the range crosses segments boundary.
+type Segments struct {
+	segs   [][]byte
+	starts []int // The position of the first byte of each segment.
+	size   int
+	pos    int
+	seg    int // The index of the segment containing the read position.
+}
+
+// NewSegments makes a new Segments instance from the slices segs.
+// Empty slices are skipped. net.Buffers can be passed as NewSegments(bufs...).
+func NewSegments(segs ...[]byte) *Segments {
+	var sd = &Segments{
+		segs:   make([][]byte, 0, len(segs)),
+		starts: make([]int, 0, len(segs)),
+	}
+	for _, s := range segs {
+		if len(s) > 0 {
+			sd.segs = append(sd.segs, s)
+			sd.starts = append(sd.starts, sd.size)
+			sd.size += len(s)
+		}
+	}
+
+	return sd
+}
+
+// NextByte reads the next byte from the segments.
+func (sd *Segments) NextByte(context.Context) (b byte, err error) {
+	if sd.pos >= sd.size {
+		return 0, io.EOF
+	}
+
+	b = sd.segs[sd.seg][sd.pos-sd.starts[sd.seg]]
+	sd.pos++
+	if sd.seg+1 < len(sd.segs) && sd.pos >= sd.starts[sd.seg+1] {
+		sd.seg++
+	}
+	return
+}
+
+// PeekByte returns the current byte in the segments.
+func (sd *Segments) PeekByte(context.Context) (b byte, err error) {
+	if sd.pos >= sd.size {
+		return 0, io.EOF
+	}
+
+	return sd.segs[sd.seg][sd.pos-sd.starts[sd.seg]], nil
+}
+
+// ByteSlice returns the slice of the segments.
+func (sd *Segments) ByteSlice(_ context.Context, start int, end int) (p []byte, err error) {
+	if end < start {
+		return nil, errStartLessEnd
+	}
+	if start < 0 || start >= sd.size {
+		return nil, errPositionOufOfBound
+	}
+	if end < 0 || end > sd.size {
+		return nil, errPositionOufOfBound
+	}
+
+	var i = sd.segmentAt(start)
+	if end <= sd.starts[i]+len(sd.segs[i]) {
+		return sd.segs[i][start-sd.starts[i] : end-sd.starts[i]], nil
+	}
+
+	p = make([]byte, 0, end-start)
+	for pos := start; pos < end; i++ {
+		var tail = sd.segs[i][pos-sd.starts[i]:]
+		if len(tail) > end-pos {
+			tail = tail[:end-pos]
+		}
+		p = append(p, tail...)
+		pos += len(tail)
+	}
+
+	return p, nil
+}
+
+// TellPosition returns the current read position.
+func (sd *Segments) TellPosition(context.Context) (pos int, err error) {
+	return sd.pos, nil
+}
+
+// SeekPosition sets the new read position.
+// The position can be set to the end of the segments.
+func (sd *Segments) SeekPosition(_ context.Context, pos int) (err error) {
+	if pos >= 0 && pos <= sd.size {
+		sd.pos = pos
+		sd.seg = sd.segmentAt(pos)
+		return nil
+	}
+	return errPositionOufOfBound
+}
+
+// segmentAt returns the index of the segment which contains the byte at the position pos.
+func (sd *Segments) segmentAt(pos int) int {
+	return sort.Search(len(sd.starts), func(i int) bool {
+		return sd.starts[i] > pos
+	}) - 1
+}
@@ -60,10 +60,16 @@ func (bd *String) TellPosition(context.Context) (pos int, err error) {
 }
 
 // SeekPosition sets the new read position.
+// The position can be set to the end of the string.
 func (bd *String) SeekPosition(_ context.Context, pos int) (err error) {
-	if pos >= 0 && pos < len(bd.buf) {
+	if pos >= 0 && pos <= len(bd.buf) {
 		bd.pos = pos
 		return nil
 	}
 	return errPositionOufOfBound
 }
+
+// Commit does nothing because the whole string stays in memory.
+func (bd *String) Commit(context.Context, int) error {
+	return nil
+}
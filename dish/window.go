@@ -0,0 +1,118 @@
+package dish
+
+import (
+	"context"
+	"io"
+
+	"github.com/workanator/bynom"
+)
+
+// Window decorates a plate and confines reading to the range of positions from start to end,
+// excluding the byte at the position end. At the end of the window reading functions return io.EOF.
+// Positions are not translated, so they match positions of the decorated plate.
+// The read position starts at the start of the window. The decorated plate must not be
+// read directly while the window is in use.
+type Window struct {
+	p      bynom.Plate
+	start  int
+	end    int
+	pos    int
+	synced bool // The read position of the decorated plate is set to pos.
+}
+
+// NewWindow makes a new Window instance which confines the plate p to the range from start to end.
+func NewWindow(p bynom.Plate, start, end int) *Window {
+	if end < start {
+		end = start
+	}
+
+	return &Window{
+		p:     p,
+		start: start,
+		end:   end,
+		pos:   start,
+	}
+}
+
+// NextByte reads the next byte from the window.
+func (wd *Window) NextByte(ctx context.Context) (b byte, err error) {
+	if wd.pos >= wd.end {
+		return 0, io.EOF
+	}
+	if err = wd.sync(ctx); err != nil {
+		return
+	}
+
+	if b, err = wd.p.NextByte(ctx); err != nil {
+		return
+	}
+	wd.pos++
+	return
+}
+
+// PeekByte returns the current byte in the window.
+func (wd *Window) PeekByte(ctx context.Context) (b byte, err error) {
+	if wd.pos >= wd.end {
+		return 0, io.EOF
+	}
+	if err = wd.sync(ctx); err != nil {
+		return
+	}
+
+	return wd.p.PeekByte(ctx)
+}
+
+// ByteSlice returns the slice of the window. The range can not cross bounds of the window.
+func (wd *Window) ByteSlice(ctx context.Context, start int, end int) ([]byte, error) {
+	if end < start {
+		return nil, errStartLessEnd
+	}
+	if start < wd.start || end > wd.end {
+		return nil, errPositionOufOfBound
+	}
+
+	return wd.p.ByteSlice(ctx, start, end)
+}
+
+// TellPosition returns the current read position.
+func (wd *Window) TellPosition(context.Context) (int, error) {
+	return wd.pos, nil
+}
+
+// SeekPosition sets the new read position.
+// The position can be set to the end of the window and can not be set outside of the window.
+func (wd *Window) SeekPosition(ctx context.Context, pos int) (err error) {
+	if pos < wd.start || pos > wd.end {
+		return errPositionOufOfBound
+	}
+
+	if err = wd.p.SeekPosition(ctx, pos); err != nil {
+		return
+	}
+	wd.pos = pos
+	wd.synced = true
+	return
+}
+
+// Commit commits the decorated plate if it implements bynom.Committer.
+func (wd *Window) Commit(ctx context.Context, pos int) (err error) {
+	if c, ok := wd.p.(bynom.Committer); ok {
+		return c.Commit(ctx, pos)
+	}
+
+	return
+}
+
+// sync sets the read position of the decorated plate to the read position of the window
+// when the window is read first time.
+func (wd *Window) sync(ctx context.Context) (err error) {
+	if wd.synced {
+		return
+	}
+
+	if err = wd.p.SeekPosition(ctx, wd.pos); err != nil {
+		return
+	}
+	wd.synced = true
+	return
+}
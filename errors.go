@@ -2,11 +2,43 @@ package bynom
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 )
 
+// ErrNeedMore notifies that the plate has no more bytes buffered yet but the input is not finished.
+// Parsers do not treat ErrNeedMore as a failure they can recover from, so the error reaches Bite.Eat
+// which reverts the read position and returns ErrNeedMore allowing to retry when more bytes available.
+var ErrNeedMore = errors.New("need more bytes")
+
+// ErrNoProgress notifies that a repeated parser succeeded without consuming input,
+// so repeating it would never finish.
+var ErrNoProgress = errors.New("parser succeeded without consuming input")
+
+var (
+	errRefNotSet          = errors.New("reference not set")
+	errAtomNotSet         = errors.New("expression atom not set")
+	errPositionOutOfBound = errors.New("position out of bounds")
+	errStartLessEnd       = errors.New("start position less than end position")
+	errInvalidBitCount    = errors.New("bit count out of range 0..64")
+)
+
+// ErrCut marks the error of parsers wrapped with Cut as fatal.
+// Parsers do not try alternatives when a parser fails with ErrCut, so the error reaches Bite.Eat.
+type ErrCut struct {
+	Err error
+}
+
+func (e *ErrCut) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ErrCut) Unwrap() error {
+	return e.Err
+}
+
 // ErrExpectationFailed describes what have been expected and what encountered.
 type ErrExpectationFailed struct {
 	Expected interface{} // Which range has been expected.
@@ -15,20 +47,185 @@ type ErrExpectationFailed struct {
 }
 
 func (e ErrExpectationFailed) Error() string {
-	var expected string
-	switch v := e.Expected.(type) {
-	case fmt.Stringer:
-		expected = v.String()
-	case byte:
-		expected = "'" + string(v) + "'"
-	default:
-		expected = fmt.Sprint(v)
+	var expected = expectedString(e.Expected)
+	if e.Not {
+		return fmt.Sprintf("expectation failed: expected not %s", expected)
 	}
+	return fmt.Sprintf("expectation failed: expected %v, have '%s'", expected, string(e.Have))
+}
 
+// ErrRuneExpectationFailed describes which rune have been expected and which encountered.
+type ErrRuneExpectationFailed struct {
+	Expected interface{} // Which rune or range has been expected.
+	Have     rune        // Which rune encountered.
+	Not      bool        // Not negates the meaning of Expected.
+}
+
+func (e ErrRuneExpectationFailed) Error() string {
+	var expected = expectedString(e.Expected)
 	if e.Not {
 		return fmt.Sprintf("expectation failed: expected not %s", expected)
 	}
-	return fmt.Sprintf("expectation failed: expected %v, have '%s'", expected, string(e.Have))
+	return fmt.Sprintf("expectation failed: expected %s, have %s", expected, strconv.QuoteRune(e.Have))
+}
+
+// ErrBitsExpectationFailed describes which bits have been expected and which encountered.
+type ErrBitsExpectationFailed struct {
+	Len      int    // The amount of bits.
+	Expected uint64 // Which bits have been expected.
+	Have     uint64 // Which bits encountered.
+}
+
+func (e ErrBitsExpectationFailed) Error() string {
+	return fmt.Sprintf("expectation failed: expected bits %0*b, have %0*b", e.Len, e.Expected, e.Len, e.Have)
+}
+
+// ErrExpectedOneOf describes the failure of all alternatives which reached the same furthest position.
+type ErrExpectedOneOf struct {
+	Expected []string // Descriptions of what alternatives have expected.
+	Errs     []error  // Errors of alternatives failed at the furthest position.
+	Pos      int      // The position the failed parsers of alternatives started at.
+}
+
+func (e ErrExpectedOneOf) Error() string {
+	return "expectation failed: expected one of " + strings.Join(e.Expected, ", ")
+}
+
+// Unwrap returns errors of all alternatives, so errors.Is and errors.As can find them.
+func (e ErrExpectedOneOf) Unwrap() []error {
+	return e.Errs
+}
+
+// expectedOneOf combines errors errs of alternatives failed at the same position pos.
+// If errs contain only one error the function returns it. If one of errors does not describe
+// what has been expected, e.g. the conversion failed, the function returns that error,
+// so the failure is not hidden behind the list of expectations.
+func expectedOneOf(errs []error, pos int) error {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	var (
+		expected []string
+		seen     = make(map[string]struct{})
+	)
+	for _, err := range errs {
+		var descs = describeExpected(err)
+		if len(descs) == 0 {
+			return err
+		}
+		for _, s := range descs {
+			if _, ok := seen[s]; !ok {
+				seen[s] = struct{}{}
+				expected = append(expected, s)
+			}
+		}
+	}
+
+	return ErrExpectedOneOf{
+		Expected: expected,
+		Errs:     errs,
+		Pos:      pos,
+	}
+}
+
+// failedAt returns the position the parser failed with err has failed at. For expectations it is
+// the position the innermost parser failed has started at, so parsers which consume the byte not matched
+// and parsers which do not consume it are compared by the same position. For other failures,
+// e.g. Take failed to convert bytes parsed, it is the end of the range parsed.
+// If err does not tell the position the function returns startPos.
+func failedAt(err error, startPos int) int {
+	var start, end = startPos, -1
+	for {
+		switch v := err.(type) {
+		case *ErrBreadcrumb:
+			if v.StartPos >= 0 {
+				start = v.StartPos
+			}
+			if v.EndPos >= 0 {
+				end = v.EndPos
+			}
+			err = v.Err
+		case ErrExpectedOneOf:
+			return v.Pos
+		default:
+			if len(describeExpected(err)) == 0 && end >= 0 {
+				return end
+			}
+			return start
+		}
+	}
+}
+
+// describeExpected returns descriptions of what has been expected by the parser failed with err.
+func describeExpected(err error) []string {
+	for {
+		switch v := err.(type) {
+		case *ErrBreadcrumb:
+			err = v.Err
+		case *ErrCut:
+			err = v.Err
+		case ErrExpectationFailed:
+			if v.Not {
+				return []string{"not " + expectedString(v.Expected)}
+			}
+			return []string{expectedString(v.Expected)}
+		case ErrRuneExpectationFailed:
+			if v.Not {
+				return []string{"not " + expectedString(v.Expected)}
+			}
+			return []string{expectedString(v.Expected)}
+		case ErrBitsExpectationFailed:
+			return []string{fmt.Sprintf("bits %0*b", v.Len, v.Expected)}
+		case ErrExpectedOneOf:
+			return v.Expected
+		default:
+			return nil
+		}
+	}
+}
+
+// expectedString formats the expected value of expectation errors.
+func expectedString(v interface{}) string {
+	switch v := v.(type) {
+	case fmt.Stringer:
+		return v.String()
+	case byte:
+		return "'" + string(v) + "'"
+	case rune:
+		return strconv.QuoteRune(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// ErrInvalidUTF8 notifies that bytes at the position Pos do not form a valid UTF-8 sequence.
+type ErrInvalidUTF8 struct {
+	Pos  int  // The position of the invalid sequence.
+	Have byte // The first byte of the invalid sequence.
+}
+
+func (e ErrInvalidUTF8) Error() string {
+	return fmt.Sprintf("invalid UTF-8 sequence at position %d starting with byte 0x%02X", e.Pos, e.Have)
+}
+
+// ErrVarintOverflow notifies that the variable-length integer at the position Pos does not fit into 64 bits.
+type ErrVarintOverflow struct {
+	Pos int // The position of the integer.
+}
+
+func (e ErrVarintOverflow) Error() string {
+	return fmt.Sprintf("variable-length integer at position %d overflows 64 bits", e.Pos)
+}
+
+// ErrVarintOverlong notifies that the variable-length integer at the position Pos
+// is encoded with more bytes than necessary.
+type ErrVarintOverlong struct {
+	Pos int // The position of the integer.
+}
+
+func (e ErrVarintOverlong) Error() string {
+	return fmt.Sprintf("variable-length integer at position %d has overlong encoding", e.Pos)
 }
 
 // ErrStateTestFailed notifies that state test against value Assert failed.
@@ -51,11 +248,31 @@ func (e ErrRequirementNotMet) Error() string {
 	return fmt.Sprintf("requirement not met: %s: expected %v, have %v", e.Msg, e.Expected, e.Have)
 }
 
+// ErrMaxDepthExceeded notifies that the recursion depth of parsers exceeded the maximum allowed.
+type ErrMaxDepthExceeded struct {
+	MaxDepth int // The maximum depth allowed.
+}
+
+func (e ErrMaxDepthExceeded) Error() string {
+	return fmt.Sprintf("maximum recursion depth %d exceeded", e.MaxDepth)
+}
+
+// ErrUnexpectedMatch notifies that parsers expected to fail finished with success.
+type ErrUnexpectedMatch struct {
+	Have []byte // Bytes matched.
+}
+
+func (e ErrUnexpectedMatch) Error() string {
+	return fmt.Sprintf("unexpected match: '%s'", string(e.Have))
+}
+
 // ErrParseFailed contains the original error happened and the parse context.
 type ErrParseFailed struct {
 	Err      error
 	StartPos int
 	EndPos   int
+	Line     int // 1-based line of the position EndPos, 0 if unknown.
+	Column   int // 1-based column of the position EndPos, 0 if unknown.
 	Context  *ParseContext
 	Stack    []Breadcrumb
 }
@@ -64,6 +281,9 @@ func (e *ErrParseFailed) Error() string {
 	var sb strings.Builder
 	sb.WriteString(e.Err.Error())
 	sb.WriteString(fmt.Sprintf(", start position: %d, end position: %d", e.StartPos, e.EndPos))
+	if e.Line > 0 {
+		sb.WriteString(fmt.Sprintf(", line: %d, column: %d", e.Line, e.Column))
+	}
 
 	if e.Context != nil {
 		sb.WriteString(", context: ")
@@ -119,6 +339,16 @@ func (e *ErrParseFailed) CopyContext(ctx context.Context, p Plate, startPos, end
 	}
 }
 
+// CopyLocation fills the line and column of the position pos if the plate p implements Locator.
+func (e *ErrParseFailed) CopyLocation(ctx context.Context, p Plate, pos int) {
+	if l, ok := p.(Locator); ok {
+		var line, column, err = l.LocatePosition(ctx, pos)
+		if err == nil {
+			e.Line, e.Column = line, column
+		}
+	}
+}
+
 func (e *ErrParseFailed) UnwrapBreadcrumbs() {
 	for {
 		if e.Err == nil {
@@ -128,12 +358,41 @@ func (e *ErrParseFailed) UnwrapBreadcrumbs() {
 		if v, ok := e.Err.(*ErrBreadcrumb); ok {
 			e.Stack = append(e.Stack, v.Breadcrumb)
 			e.Err = v.Err
+		} else if v, ok := e.Err.(*ErrCut); ok {
+			e.Err = v.Err
 		} else {
 			break
 		}
 	}
 }
 
+// ErrParseFailures contains all failures happened during parsing, including failures
+// parsers made with Recover recovered from.
+type ErrParseFailures struct {
+	Errs []error
+}
+
+func (e *ErrParseFailures) Error() string {
+	var sb strings.Builder
+	sb.WriteString(strconv.Itoa(len(e.Errs)))
+	sb.WriteString(" parse failures")
+	for i, err := range e.Errs {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(err.Error())
+	}
+
+	return sb.String()
+}
+
+// Unwrap returns all failures, so errors.Is and errors.As can find them.
+func (e *ErrParseFailures) Unwrap() []error {
+	return e.Errs
+}
+
 type ErrBreadcrumb struct {
 	Err error
 	Breadcrumb
@@ -143,6 +402,10 @@ func (e *ErrBreadcrumb) Error() string {
 	return e.Breadcrumb.String() + ": " + e.Err.Error()
 }
 
+func (e *ErrBreadcrumb) Unwrap() error {
+	return e.Err
+}
+
 func WrapBreadcrumb(err error, name string, index int) *ErrBreadcrumb {
 	return &ErrBreadcrumb{
 		Err: err,
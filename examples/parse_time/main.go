@@ -29,8 +29,8 @@ var (
 
 var (
 	digits     = WhileAcceptable(span.Range('0', '9'))
-	twoDigits  = RequireLen(2, digits)
-	fourDigits = RequireLen(4, digits)
+	twoDigits  = Memo(RequireLen(2, digits))
+	fourDigits = Memo(RequireLen(4, digits))
 )
 
 var (
@@ -155,3 +155,29 @@ func ExpectIneligible(r Relevance) Nom {
 		return
 	}
 }
+
+// ExpectRune reads the next UTF-8 encoded rune from the plate and tests it against r.
+// If the rune read does not equal r the function will return ErrRuneExpectationFailed.
+// If the bytes read are not valid UTF-8 the function will return ErrInvalidUTF8.
+func ExpectRune(r rune) Nom {
+	const funcName = "ExpectRune"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var v rune
+		if v, _, err = NextRune(ctx, p); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+		if v == r {
+			return nil
+		}
+
+		return WrapBreadcrumb(
+			ErrRuneExpectationFailed{
+				Expected: r,
+				Have:     v,
+			},
+			funcName,
+			-1,
+		)
+	}
+}
@@ -0,0 +1,226 @@
+package bynom
+
+import (
+	"context"
+	"math"
+)
+
+// Assoc defines the associativity of infix operators.
+type Assoc int
+
+const (
+	AssocLeft  Assoc = iota // Operators group left to right, e.g. a-b-c is (a-b)-c.
+	AssocRight              // Operators group right to left, e.g. a^b^c is a^(b^c).
+)
+
+// Expression builds the parser of expressions consisting of operands parsed with the atom parser
+// and prefix, infix and postfix operators with precedences and associativity.
+// Operators with the higher precedence bind tighter. The result is built by calling
+// the functions given for operators, so the parser can build a tree or evaluate the expression.
+//
+// Operators are tried in order they added, precedences can be any integers. Operators must consume bytes,
+// the operator which matches without consuming bytes fails the parser with ErrNoProgress.
+// Parenthesized sub-expressions can be parsed by the atom parser which refers to Expression.Parser.
+type Expression[T any] struct {
+	atom    Parser[T]
+	prefix  []prefixOp[T]
+	infix   []infixOp[T]
+	postfix []prefixOp[T]
+}
+
+type prefixOp[T any] struct {
+	op   Nom
+	prec int
+	fn   func(T) (T, error)
+}
+
+type infixOp[T any] struct {
+	op    Nom
+	prec  int
+	assoc Assoc
+	fn    func(T, T) (T, error)
+}
+
+// NewExpression makes a new Expression instance without operators.
+func NewExpression[T any]() *Expression[T] {
+	return &Expression[T]{}
+}
+
+// Atom sets the parser of operands. Atom must be called before the parsing starts.
+func (e *Expression[T]) Atom(atom Parser[T]) *Expression[T] {
+	e.atom = atom
+	return e
+}
+
+// Prefix adds the prefix operator parsed with op with the precedence prec.
+// The function fn is called with the operand to build the result.
+func (e *Expression[T]) Prefix(op Nom, prec int, fn func(T) (T, error)) *Expression[T] {
+	e.prefix = append(e.prefix, prefixOp[T]{op: op, prec: prec, fn: fn})
+	return e
+}
+
+// Infix adds the infix operator parsed with op with the precedence prec and the associativity assoc.
+// The function fn is called with the left and right operands to build the result.
+func (e *Expression[T]) Infix(op Nom, prec int, assoc Assoc, fn func(T, T) (T, error)) *Expression[T] {
+	e.infix = append(e.infix, infixOp[T]{op: op, prec: prec, assoc: assoc, fn: fn})
+	return e
+}
+
+// Postfix adds the postfix operator parsed with op with the precedence prec.
+// The function fn is called with the operand to build the result.
+func (e *Expression[T]) Postfix(op Nom, prec int, fn func(T) (T, error)) *Expression[T] {
+	e.postfix = append(e.postfix, prefixOp[T]{op: op, prec: prec, fn: fn})
+	return e
+}
+
+// Parser returns the parser of the expression.
+// The parser uses operators which are added to the expression at the moment of parsing,
+// so it can be used in the atom parser for parenthesized sub-expressions.
+// Each nested operand increases the recursion depth, see WithMaxDepth.
+func (e *Expression[T]) Parser() Parser[T] {
+	return func(ctx context.Context, p Plate) (T, error) {
+		return e.parse(ctx, p, math.MinInt)
+	}
+}
+
+// parse parses the expression which contains operators with the precedence minPrec and higher.
+func (e *Expression[T]) parse(ctx context.Context, p Plate, minPrec int) (v T, err error) {
+	const funcName = "Expression"
+
+	if e.atom == nil {
+		return v, WrapBreadcrumb(errAtomNotSet, funcName, -1)
+	}
+
+	var leave func()
+	if ctx, leave, err = enterRecursion(ctx); err != nil {
+		return v, WrapBreadcrumb(err, funcName, -1)
+	}
+	defer leave()
+
+	var (
+		i        int
+		matched  bool
+		startPos int
+	)
+	if startPos, err = p.TellPosition(ctx); err != nil {
+		return v, WrapBreadcrumb(err, funcName, -1)
+	}
+
+	// Parse prefix operators or the atom.
+	for i = range e.prefix {
+		if matched, err = e.matchOp(ctx, p, e.prefix[i].op, startPos); err != nil {
+			return v, ExtendBreadcrumb(WrapBreadcrumb(err, "Prefix", i), startPos, -1)
+		}
+		if matched {
+			break
+		}
+	}
+	if matched {
+		var op = e.prefix[i]
+		if v, err = e.operand(ctx, p, "Prefix", i, op.prec); err != nil {
+			return
+		}
+		if v, err = op.fn(v); err != nil {
+			var errPos, _ = p.TellPosition(ctx)
+			return v, ExtendBreadcrumb(WrapBreadcrumb(err, "Prefix", i), startPos, errPos)
+		}
+	} else if v, err = e.atom(ctx, p); err != nil {
+		var errPos, _ = p.TellPosition(ctx)
+		return v, ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, errPos)
+	}
+
+	// Parse postfix and infix operators which bind not weaker than minPrec.
+OpLoop:
+	for {
+		var opStartPos int
+		if opStartPos, err = p.TellPosition(ctx); err != nil {
+			return v, WrapBreadcrumb(err, funcName, -1)
+		}
+
+		for i = range e.postfix {
+			var op = e.postfix[i]
+			if op.prec < minPrec {
+				continue
+			}
+			if matched, err = e.matchOp(ctx, p, op.op, opStartPos); err != nil {
+				return v, ExtendBreadcrumb(WrapBreadcrumb(err, "Postfix", i), opStartPos, -1)
+			}
+			if matched {
+				if v, err = op.fn(v); err != nil {
+					var errPos, _ = p.TellPosition(ctx)
+					return v, ExtendBreadcrumb(WrapBreadcrumb(err, "Postfix", i), opStartPos, errPos)
+				}
+				continue OpLoop
+			}
+		}
+
+		for i = range e.infix {
+			var op = e.infix[i]
+			if op.prec < minPrec {
+				continue
+			}
+			if matched, err = e.matchOp(ctx, p, op.op, opStartPos); err != nil {
+				return v, ExtendBreadcrumb(WrapBreadcrumb(err, "Infix", i), opStartPos, -1)
+			}
+			if matched {
+				var nextPrec = op.prec + 1
+				if op.assoc == AssocRight {
+					nextPrec = op.prec
+				}
+
+				var rhs T
+				if rhs, err = e.operand(ctx, p, "Infix", i, nextPrec); err != nil {
+					return
+				}
+				if v, err = op.fn(v, rhs); err != nil {
+					var errPos, _ = p.TellPosition(ctx)
+					return v, ExtendBreadcrumb(WrapBreadcrumb(err, "Infix", i), opStartPos, errPos)
+				}
+				continue OpLoop
+			}
+		}
+
+		return v, nil
+	}
+}
+
+// operand parses the operand of the operator with the index i.
+func (e *Expression[T]) operand(ctx context.Context, p Plate, opName string, i int, prec int) (v T, err error) {
+	var startPos int
+	if startPos, err = p.TellPosition(ctx); err != nil {
+		return v, WrapBreadcrumb(err, opName, i)
+	}
+
+	if v, err = e.parse(ctx, p, prec); err != nil {
+		var errPos, _ = p.TellPosition(ctx)
+		return v, ExtendBreadcrumb(WrapBreadcrumb(err, opName, i), startPos, errPos)
+	}
+
+	return
+}
+
+// matchOp runs the operator parser op. If op fails the function reverts back the read position to startPos
+// and returns false. The function returns non-nil error only if op fails with fatal error, op matches
+// without consuming bytes or the read position can not be reverted.
+func (e *Expression[T]) matchOp(ctx context.Context, p Plate, op Nom, startPos int) (matched bool, err error) {
+	var (
+		rec  = recoveryOf(ctx)
+		mark = rec.mark()
+	)
+	if err = op(ctx, p); err == nil {
+		var endPos int
+		if endPos, err = p.TellPosition(ctx); err != nil {
+			return false, err
+		}
+		if endPos == startPos {
+			return false, ErrNoProgress
+		}
+		return true, nil
+	}
+	if isFatal(err) {
+		return false, err
+	}
+
+	rec.rollback(mark)
+	return false, p.SeekPosition(ctx, startPos)
+}
@@ -0,0 +1,47 @@
+package bynom
+
+import (
+	"context"
+	"io"
+)
+
+// Feast repeatedly asks the Feeder f to feed the parser nom until f runs out of bytes, i.e. returns io.EOF.
+// If the parser fails the function calls onError passing it the index of the portion fed and the error.
+// If onError returns nil the function proceeds to the next portion, otherwise it stops with that error.
+// If onError is nil the function stops with the first error of the parser.
+// If the Feeder f fails by itself the function stops with that error.
+//
+// Bite can be fed by passing the method value Bite.Eat as the parser nom.
+func Feast(ctx context.Context, f Feeder, nom Nom, onError func(int, error) error) (err error) {
+	for i := 0; ; i++ {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		var (
+			fed    bool
+			nomErr error
+		)
+		err = f.Feed(ctx, func(ctx context.Context, p Plate) error {
+			fed = true
+			nomErr = nom(ctx, p)
+			return nomErr
+		})
+		if err == nil {
+			continue
+		}
+		if err == io.EOF && !fed {
+			return nil
+		}
+		if !fed || nomErr == nil {
+			return
+		}
+
+		if onError == nil {
+			return
+		}
+		if err = onError(i, err); err != nil {
+			return
+		}
+	}
+}
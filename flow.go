@@ -3,7 +3,11 @@ package bynom
 import "context"
 
 // Switch takes the result of the first parser from noms which finished without error.
-// If all noms failed the function will return the last error encountered.
+// If all noms failed the function will return the error of the parser which failed at the furthest position,
+// i.e. the position the innermost parser failed has started at.
+// If multiple parsers failed at the furthest position the function will return ErrExpectedOneOf
+// listing what all of them have expected.
+// If a parser fails with ErrNeedMore or ErrCut the function fails without trying the rest of noms.
 func Switch(noms ...Nom) Nom {
 	const funcName = "Switch"
 
@@ -13,6 +17,13 @@ func Switch(noms ...Nom) Nom {
 			return WrapBreadcrumb(err, funcName, -1)
 		}
 
+		var (
+			rec          = recoveryOf(ctx)
+			mark         = rec.mark()
+			furthestPos  = -1
+			furthestEnd  int
+			furthestErrs []error
+		)
 		for i, nom := range noms {
 			if i > 0 {
 				if err = p.SeekPosition(ctx, startPos); err != nil {
@@ -20,11 +31,32 @@ func Switch(noms ...Nom) Nom {
 				}
 			}
 
-			if err = nom(ctx, p); err == nil {
+			if err = nom(ctx, p); err == nil || isFatal(err) {
 				break
 			}
+			rec.rollback(mark)
+
+			var (
+				nomErrPos, _ = p.TellPosition(ctx)
+				nomFailPos   = failedAt(err, startPos)
+			)
+			if nomFailPos > furthestPos {
+				furthestPos = nomFailPos
+				furthestEnd = nomErrPos
+				furthestErrs = furthestErrs[:0]
+			}
+			if nomFailPos == furthestPos {
+				if nomErrPos > furthestEnd {
+					furthestEnd = nomErrPos
+				}
+				furthestErrs = append(furthestErrs, err)
+			}
 		}
 		if err != nil {
+			if !isFatal(err) {
+				err = expectedOneOf(furthestErrs, furthestPos)
+				_ = p.SeekPosition(ctx, furthestEnd)
+			}
 			return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, -1)
 		}
 
@@ -66,6 +98,7 @@ func When(test Nom, noms ...Nom) Nom {
 
 // WhenNot implements conditional parsing. When the parser test finishes with non-nil error
 // noms run. If one of parsers in noms fails the function fails with that error.
+// If the parser test fails with ErrNeedMore or ErrCut the function fails with that error.
 func WhenNot(test Nom, noms ...Nom) Nom {
 	const funcName = "WhenNot"
 
@@ -75,12 +108,20 @@ func WhenNot(test Nom, noms ...Nom) Nom {
 			return WrapBreadcrumb(err, funcName, -1)
 		}
 
+		var (
+			rec  = recoveryOf(ctx)
+			mark = rec.mark()
+		)
 		if err = test(ctx, p); err == nil {
+			rec.rollback(mark)
 			if err = p.SeekPosition(ctx, startPos); err != nil {
 				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, -1)
 			}
 			return
+		} else if isFatal(err) {
+			return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, -1)
 		} else {
+			rec.rollback(mark)
 			err = nil
 		}
 
@@ -103,6 +144,7 @@ func WhenNot(test Nom, noms ...Nom) Nom {
 // Optional runs all parsers noms until all finished or at least one failed.
 // If at least one of parsers return non-nil error the function
 // will revert back the read position in the plate and return nil.
+// If a parser fails with ErrNeedMore or ErrCut the function fails with that error.
 func Optional(noms ...Nom) Nom {
 	const funcName = "Optional"
 
@@ -112,8 +154,16 @@ func Optional(noms ...Nom) Nom {
 			return WrapBreadcrumb(err, funcName, -1)
 		}
 
+		var (
+			rec  = recoveryOf(ctx)
+			mark = rec.mark()
+		)
 		for i, nom := range noms {
 			if err = nom(ctx, p); err != nil {
+				if isFatal(err) {
+					return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, i), startPos, -1)
+				}
+				rec.rollback(mark)
 				if err = p.SeekPosition(ctx, startPos); err != nil {
 					return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, i), startPos, -1)
 				}
@@ -163,8 +213,26 @@ func Repeat(n int, noms ...Nom) Nom {
 
 // Sequence runs all parsers noms until all finished or at least one failed.
 func Sequence(noms ...Nom) Nom {
-	const funcName = "Sequence"
+	return sequence("Sequence", noms)
+}
+
+// Delimited runs parsers open, body and close in sequence.
+// It is useful for parsing something enclosed in brackets or quotes.
+func Delimited(open, body, close Nom) Nom {
+	return sequence("Delimited", []Nom{open, body, close})
+}
+
+// Terminated runs parsers body and term in sequence.
+func Terminated(body, term Nom) Nom {
+	return sequence("Terminated", []Nom{body, term})
+}
+
+// Preceded runs parsers prefix and body in sequence.
+func Preceded(prefix, body Nom) Nom {
+	return sequence("Preceded", []Nom{prefix, body})
+}
 
+func sequence(funcName string, noms []Nom) Nom {
 	return func(ctx context.Context, p Plate) (err error) {
 		for i, nom := range noms {
 			var nomStartPos int
@@ -0,0 +1,129 @@
+package bynom
+
+import (
+	"context"
+	"fmt"
+)
+
+const maxInt = int(^uint(0) >> 1)
+
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// IntOf converts the constructor of parsers which read integers, e.g. U16BE or Uvarint,
+// into Parser which returns the integer read as int. The integer is stored in the variable
+// local to each run, so the parser is safe for concurrent use.
+// If the integer is negative or does not fit into int the parser fails with ErrRequirementNotMet.
+func IntOf[T integer](fn func(*T) Nom) Parser[int] {
+	const funcName = "IntOf"
+
+	return func(ctx context.Context, p Plate) (n int, err error) {
+		var v T
+		if err = fn(&v)(ctx, p); err != nil {
+			return 0, err
+		}
+
+		if v < 0 || uint64(v) > uint64(maxInt) {
+			return 0, WrapBreadcrumb(
+				ErrRequirementNotMet{
+					Expected: fmt.Sprintf("0..%d", maxInt),
+					Have:     v,
+					Msg:      "integer out of range",
+				},
+				funcName,
+				-1,
+			)
+		}
+
+		return int(v), nil
+	}
+}
+
+// LengthPrefixed parses the length with the parser length and then runs the parser body
+// which is not allowed to read beyond the length parsed, it gets io.EOF at the boundary.
+// Like in Then the index of the breadcrumb is 0 for the length and 1 for the body.
+// If body consumes less bytes than the length the function will return ErrRequirementNotMet.
+func LengthPrefixed(length Parser[int], body Nom) Nom {
+	const funcName = "LengthPrefixed"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var n int
+		if n, err = length(ctx, p); err != nil {
+			return WrapBreadcrumb(err, funcName, 0)
+		}
+
+		var bodyStartPos int
+		if bodyStartPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, 1)
+		}
+
+		var lp *limitPlate
+		if lp, err = newLimitPlate(ctx, p, bodyStartPos+n); err != nil {
+			return WrapBreadcrumb(err, funcName, 1)
+		}
+		if err = body(ctx, lp); err != nil {
+			var bodyErrPos, _ = p.TellPosition(ctx)
+			return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, 1), bodyStartPos, bodyErrPos)
+		}
+
+		var bodyEndPos int
+		if bodyEndPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, 1)
+		}
+		if l := bodyEndPos - bodyStartPos; l != n {
+			return ExtendBreadcrumb(
+				WrapBreadcrumb(
+					ErrRequirementNotMet{
+						Expected: n,
+						Have:     l,
+						Msg:      "invalid length",
+					},
+					funcName,
+					1,
+				),
+				bodyStartPos,
+				bodyEndPos,
+			)
+		}
+
+		return
+	}
+}
+
+// CountPrefixed parses the count with the parser count and then runs the parser item that many times.
+// If the count or the item fails the function fails with that error. Like in Then the index of the breadcrumb
+// is 0 for the count, for items it is the index of the item failed plus one. If the item succeeds without consuming bytes the function fails with ErrNoProgress,
+// so a hostile count can not make it spin.
+func CountPrefixed(count Parser[int], item Nom) Nom {
+	const funcName = "CountPrefixed"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var n int
+		if n, err = count(ctx, p); err != nil {
+			return WrapBreadcrumb(err, funcName, 0)
+		}
+
+		for i := 0; i < n; i++ {
+			var itemStartPos int
+			if itemStartPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, i+1)
+			}
+
+			if err = item(ctx, p); err != nil {
+				var itemErrPos, _ = p.TellPosition(ctx)
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, i+1), itemStartPos, itemErrPos)
+			}
+
+			var itemEndPos int
+			if itemEndPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, i+1)
+			}
+			if itemEndPos == itemStartPos {
+				return ExtendBreadcrumb(WrapBreadcrumb(ErrNoProgress, funcName, i+1), itemStartPos, itemEndPos)
+			}
+		}
+
+		return
+	}
+}
@@ -0,0 +1,22 @@
+package into
+
+import (
+	"strconv"
+
+	"github.com/workanator/bynom"
+)
+
+// Bool converts the byte slice into bool and assigns the result to the variable p.
+// The byte slice is interpreted as in strconv.ParseBool.
+// If the byte slice does not represent a boolean value the function returns ErrConversionFailed.
+func Bool(p *bool) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseBool(string(b))
+		if err != nil {
+			return conversionFailed("bool", b, err)
+		}
+
+		*p = v
+		return nil
+	}
+}
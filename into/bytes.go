@@ -3,9 +3,29 @@ package into
 import "github.com/workanator/bynom"
 
 // Bytes assigns byte slice to the variable p.
+// The byte slice may alias the plate buffer. Plates like dish.Reader or dish.Buffer do not overwrite
+// bytes released on commit, but the slice keeps the whole buffer it aliases from being reclaimed.
+// Use CopyBytes to keep the value without holding the plate buffer.
 func Bytes(p *[]byte) bynom.Convert {
 	return func(b []byte) error {
 		*p = b
 		return nil
 	}
 }
+
+// CopyBytes assigns the copy of byte slice to the variable p.
+func CopyBytes(p *[]byte) bynom.Convert {
+	return func(b []byte) error {
+		*p = append([]byte(nil), b...)
+		return nil
+	}
+}
+
+// AppendBytes appends the copy of byte slice to the slice p.
+// It allows collecting the results of parsers repeated multiple times.
+func AppendBytes(p *[][]byte) bynom.Convert {
+	return func(b []byte) error {
+		*p = append(*p, append([]byte(nil), b...))
+		return nil
+	}
+}
@@ -0,0 +1,36 @@
+package into
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrConversionFailed describes the failure of conversion the input into the value of type Type.
+type ErrConversionFailed struct {
+	Type  string // The name of the target type.
+	Input string // The input which failed to convert.
+	Err   error  // The reason of the failure, e.g. strconv.ErrSyntax or strconv.ErrRange.
+}
+
+func (e ErrConversionFailed) Error() string {
+	return fmt.Sprintf("conversion failed: can not convert %q into %s: %v", e.Input, e.Type, e.Err)
+}
+
+func (e ErrConversionFailed) Unwrap() error {
+	return e.Err
+}
+
+// conversionFailed makes ErrConversionFailed from the error err returned by strconv.
+func conversionFailed(typeName string, b []byte, err error) error {
+	var ne *strconv.NumError
+	if errors.As(err, &ne) {
+		err = ne.Err
+	}
+
+	return ErrConversionFailed{
+		Type:  typeName,
+		Input: string(b),
+		Err:   err,
+	}
+}
@@ -0,0 +1,37 @@
+package into
+
+import (
+	"strconv"
+
+	"github.com/workanator/bynom"
+)
+
+// Float32 converts the byte slice into float32 and assigns the result to the variable p.
+// The byte slice is interpreted as in strconv.ParseFloat. If the value does not fit into float32
+// the function returns ErrConversionFailed.
+func Float32(p *float32) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseFloat(string(b), 32)
+		if err != nil {
+			return conversionFailed("float32", b, err)
+		}
+
+		*p = float32(v)
+		return nil
+	}
+}
+
+// Float64 converts the byte slice into float64 and assigns the result to the variable p.
+// The byte slice is interpreted as in strconv.ParseFloat. If the value does not fit into float64
+// the function returns ErrConversionFailed.
+func Float64(p *float64) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseFloat(string(b), 64)
+		if err != nil {
+			return conversionFailed("float64", b, err)
+		}
+
+		*p = float64(v)
+		return nil
+	}
+}
@@ -0,0 +1,82 @@
+package into
+
+import (
+	"strconv"
+
+	"github.com/workanator/bynom"
+)
+
+// Int converts the byte slice into int in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseInt. If the value does not fit into int
+// the function returns ErrConversionFailed.
+func Int(p *int, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseInt(string(b), base, strconv.IntSize)
+		if err != nil {
+			return conversionFailed("int", b, err)
+		}
+
+		*p = int(v)
+		return nil
+	}
+}
+
+// Int8 converts the byte slice into int8 in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseInt. If the value does not fit into int8
+// the function returns ErrConversionFailed.
+func Int8(p *int8, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseInt(string(b), base, 8)
+		if err != nil {
+			return conversionFailed("int8", b, err)
+		}
+
+		*p = int8(v)
+		return nil
+	}
+}
+
+// Int16 converts the byte slice into int16 in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseInt. If the value does not fit into int16
+// the function returns ErrConversionFailed.
+func Int16(p *int16, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseInt(string(b), base, 16)
+		if err != nil {
+			return conversionFailed("int16", b, err)
+		}
+
+		*p = int16(v)
+		return nil
+	}
+}
+
+// Int32 converts the byte slice into int32 in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseInt. If the value does not fit into int32
+// the function returns ErrConversionFailed.
+func Int32(p *int32, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseInt(string(b), base, 32)
+		if err != nil {
+			return conversionFailed("int32", b, err)
+		}
+
+		*p = int32(v)
+		return nil
+	}
+}
+
+// Int64 converts the byte slice into int64 in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseInt. If the value does not fit into int64
+// the function returns ErrConversionFailed.
+func Int64(p *int64, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseInt(string(b), base, 64)
+		if err != nil {
+			return conversionFailed("int64", b, err)
+		}
+
+		*p = int64(v)
+		return nil
+	}
+}
@@ -0,0 +1,69 @@
+package into
+
+import (
+	"sync"
+
+	"github.com/workanator/bynom"
+)
+
+// String assigns byte slice converted to string to the variable p.
+// The conversion copies bytes, so the string does not alias the plate buffer.
+func String(p *string) bynom.Convert {
+	return func(b []byte) error {
+		*p = string(b)
+		return nil
+	}
+}
+
+// Interner converts byte slices to strings reusing strings converted before,
+// so repeated tokens do not allocate a new string each time.
+// Interner is safe for concurrent use.
+type Interner struct {
+	mu    sync.Mutex
+	m     map[string]string
+	limit int
+}
+
+// NewInterner makes a new Interner instance which keeps at most limit strings.
+// When the limit reached new strings are still converted but not kept.
+// A non-positive limit means no limit.
+func NewInterner(limit int) *Interner {
+	return &Interner{
+		m:     make(map[string]string),
+		limit: limit,
+	}
+}
+
+// String assigns byte slice converted to string to the variable p.
+// If the same string has been converted before the function assigns that string.
+func (in *Interner) String(p *string) bynom.Convert {
+	return func(b []byte) error {
+		*p = in.Intern(b)
+		return nil
+	}
+}
+
+// Intern returns the string equal to the byte slice b reusing the string converted before if any.
+func (in *Interner) Intern(b []byte) string {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	if s, ok := in.m[string(b)]; ok {
+		return s
+	}
+
+	var s = string(b)
+	if in.limit <= 0 || len(in.m) < in.limit {
+		in.m[s] = s
+	}
+
+	return s
+}
+
+// Len returns the number of strings kept.
+func (in *Interner) Len() int {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	return len(in.m)
+}
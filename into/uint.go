@@ -0,0 +1,82 @@
+package into
+
+import (
+	"strconv"
+
+	"github.com/workanator/bynom"
+)
+
+// Uint converts the byte slice into uint in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseUint. If the value does not fit into uint
+// the function returns ErrConversionFailed.
+func Uint(p *uint, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseUint(string(b), base, strconv.IntSize)
+		if err != nil {
+			return conversionFailed("uint", b, err)
+		}
+
+		*p = uint(v)
+		return nil
+	}
+}
+
+// Uint8 converts the byte slice into uint8 in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseUint. If the value does not fit into uint8
+// the function returns ErrConversionFailed.
+func Uint8(p *uint8, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseUint(string(b), base, 8)
+		if err != nil {
+			return conversionFailed("uint8", b, err)
+		}
+
+		*p = uint8(v)
+		return nil
+	}
+}
+
+// Uint16 converts the byte slice into uint16 in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseUint. If the value does not fit into uint16
+// the function returns ErrConversionFailed.
+func Uint16(p *uint16, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseUint(string(b), base, 16)
+		if err != nil {
+			return conversionFailed("uint16", b, err)
+		}
+
+		*p = uint16(v)
+		return nil
+	}
+}
+
+// Uint32 converts the byte slice into uint32 in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseUint. If the value does not fit into uint32
+// the function returns ErrConversionFailed.
+func Uint32(p *uint32, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseUint(string(b), base, 32)
+		if err != nil {
+			return conversionFailed("uint32", b, err)
+		}
+
+		*p = uint32(v)
+		return nil
+	}
+}
+
+// Uint64 converts the byte slice into uint64 in the base and assigns the result to the variable p.
+// The base is interpreted as in strconv.ParseUint. If the value does not fit into uint64
+// the function returns ErrConversionFailed.
+func Uint64(p *uint64, base int) bynom.Convert {
+	return func(b []byte) error {
+		var v, err = strconv.ParseUint(string(b), base, 64)
+		if err != nil {
+			return conversionFailed("uint64", b, err)
+		}
+
+		*p = uint64(v)
+		return nil
+	}
+}
@@ -0,0 +1,138 @@
+package bynom
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultMaxDepth is the maximum recursion depth of parsers made with Lazy and Ref
+// if it is not set with WithMaxDepth.
+const DefaultMaxDepth = 1000
+
+type (
+	depthKey    struct{}
+	maxDepthKey struct{}
+)
+
+// recursion tracks the recursion depth of parsers made with Lazy and Ref during one call of Bite.Eat.
+// The depth is mutable, so entering the recursion does not grow the context chain.
+type recursion struct {
+	depth    int
+	maxDepth int
+}
+
+// withRecursion returns the copy of ctx with the new recursion depth counter
+// limited to the maximum depth set with WithMaxDepth. If ctx already has the counter,
+// e.g. Bite.Eat is called by parsers of another Bite.Eat, ctx is returned as is,
+// so the depth keeps counting across nested calls.
+func withRecursion(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(depthKey{}).(*recursion); ok {
+		return ctx
+	}
+
+	var rec = &recursion{maxDepth: DefaultMaxDepth}
+	if v, ok := ctx.Value(maxDepthKey{}).(int); ok {
+		rec.maxDepth = v
+	}
+
+	return context.WithValue(ctx, depthKey{}, rec)
+}
+
+// WithMaxDepth returns the copy of ctx which limits the recursion depth of parsers
+// made with Lazy and Ref to n. A non-positive n means no limit.
+func WithMaxDepth(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, maxDepthKey{}, n)
+}
+
+// Lazy makes the parser which calls fn to obtain the actual parser on the first run.
+// It allows parsers to refer to themselves or to parsers defined later, e.g. for nested structures.
+// Each run of the parser increases the recursion depth, if the depth exceeds the maximum allowed
+// the function fails with ErrMaxDepthExceeded which is fatal like ErrCut. See WithMaxDepth.
+func Lazy(fn func() Nom) Nom {
+	const funcName = "Lazy"
+
+	var (
+		once sync.Once
+		nom  Nom
+	)
+	return func(ctx context.Context, p Plate) (err error) {
+		once.Do(func() {
+			nom = fn()
+		})
+
+		var leave func()
+		if ctx, leave, err = enterRecursion(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+		defer leave()
+
+		if err = nom(ctx, p); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		return
+	}
+}
+
+// Ref is the reference to the parser which is set after the reference is used.
+// It allows mutually recursive rules.
+type Ref struct {
+	name string
+	nom  Nom
+}
+
+// NewRef makes a new Ref instance with the name which is shown in breadcrumbs.
+func NewRef(name string) *Ref {
+	return &Ref{
+		name: name,
+	}
+}
+
+// Set sets the parser the reference refers to. Set must be called before the parsing starts.
+func (r *Ref) Set(nom Nom) {
+	r.nom = nom
+}
+
+// Nom returns the parser which runs the parser the reference refers to.
+// Each run of the parser increases the recursion depth, if the depth exceeds the maximum allowed
+// the parser fails with ErrMaxDepthExceeded. See WithMaxDepth.
+func (r *Ref) Nom() Nom {
+	return func(ctx context.Context, p Plate) (err error) {
+		if r.nom == nil {
+			return WrapBreadcrumb(errRefNotSet, r.name, -1)
+		}
+
+		var leave func()
+		if ctx, leave, err = enterRecursion(ctx); err != nil {
+			return WrapBreadcrumb(err, r.name, -1)
+		}
+		defer leave()
+
+		if err = r.nom(ctx, p); err != nil {
+			return WrapBreadcrumb(err, r.name, -1)
+		}
+
+		return
+	}
+}
+
+// enterRecursion increases the recursion depth and returns the function which decreases it back.
+// The depth counter is installed into the copy of ctx returned if ctx does not have one yet,
+// e.g. when the parser runs outside of Bite.Eat.
+// If the depth exceeds the maximum allowed the function returns ErrMaxDepthExceeded.
+func enterRecursion(ctx context.Context) (context.Context, func(), error) {
+	var rec, _ = ctx.Value(depthKey{}).(*recursion)
+	if rec == nil {
+		ctx = withRecursion(ctx)
+		rec = ctx.Value(depthKey{}).(*recursion)
+	}
+
+	if rec.maxDepth > 0 && rec.depth >= rec.maxDepth {
+		return ctx, nil, ErrMaxDepthExceeded{
+			MaxDepth: rec.maxDepth,
+		}
+	}
+
+	rec.depth++
+	return ctx, func() { rec.depth-- }, nil
+}
@@ -0,0 +1,36 @@
+package bynom
+
+import "context"
+
+// Limit runs all parsers noms which are not allowed to read more than n bytes in total,
+// at the boundary reading functions return io.EOF. Unlike RequireLen the limit is enforced
+// while parsing, so a parser like While can not scan beyond the boundary.
+func Limit(n int, noms ...Nom) Nom {
+	const funcName = "Limit"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		var lp *limitPlate
+		if lp, err = newLimitPlate(ctx, p, startPos+n); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		for i, nom := range noms {
+			var nomStartPos int
+			if nomStartPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, i)
+			}
+
+			if err = nom(ctx, lp); err != nil {
+				var nomErrPos, _ = p.TellPosition(ctx)
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, i), nomStartPos, nomErrPos)
+			}
+		}
+
+		return
+	}
+}
@@ -0,0 +1,88 @@
+package bynom
+
+import (
+	"context"
+	"io"
+)
+
+// limitPlate decorates the plate and confines reading to the range of positions from the position
+// the decoration started at to end, excluding the byte at the position end. It follows the rules
+// of dish.Window, positions are not translated, so they match positions of the decorated plate.
+type limitPlate struct {
+	p     Plate
+	start int
+	end   int
+	pos   int
+}
+
+// newLimitPlate makes a new limitPlate instance which confines the plate p to the range
+// from the current read position to end.
+func newLimitPlate(ctx context.Context, p Plate, end int) (*limitPlate, error) {
+	var pos, err = p.TellPosition(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if end < pos {
+		end = pos
+	}
+
+	return &limitPlate{
+		p:     p,
+		start: pos,
+		end:   end,
+		pos:   pos,
+	}, nil
+}
+
+// NextByte reads the next byte from the plate. At the limit the function returns io.EOF.
+func (lp *limitPlate) NextByte(ctx context.Context) (b byte, err error) {
+	if lp.pos >= lp.end {
+		return 0, io.EOF
+	}
+
+	if b, err = lp.p.NextByte(ctx); err != nil {
+		return
+	}
+	lp.pos++
+	return
+}
+
+// PeekByte returns the current byte in the plate. At the limit the function returns io.EOF.
+func (lp *limitPlate) PeekByte(ctx context.Context) (b byte, err error) {
+	if lp.pos >= lp.end {
+		return 0, io.EOF
+	}
+
+	return lp.p.PeekByte(ctx)
+}
+
+// ByteSlice returns the slice of the plate. The range can not cross bounds of the plate.
+func (lp *limitPlate) ByteSlice(ctx context.Context, start int, end int) ([]byte, error) {
+	if end < start {
+		return nil, errStartLessEnd
+	}
+	if start < lp.start || end > lp.end {
+		return nil, errPositionOutOfBound
+	}
+
+	return lp.p.ByteSlice(ctx, start, end)
+}
+
+// TellPosition returns the current read position.
+func (lp *limitPlate) TellPosition(context.Context) (int, error) {
+	return lp.pos, nil
+}
+
+// SeekPosition sets the new read position.
+// The position can be set to the limit and can not be set outside of bounds of the plate.
+func (lp *limitPlate) SeekPosition(ctx context.Context, pos int) (err error) {
+	if pos < lp.start || pos > lp.end {
+		return errPositionOutOfBound
+	}
+
+	if err = lp.p.SeekPosition(ctx, pos); err != nil {
+		return
+	}
+	lp.pos = pos
+	return
+}
@@ -0,0 +1,86 @@
+package bynom
+
+import "context"
+
+// Peek runs all parsers noms and reverts back the read position in the plate regardless of the result.
+// If at least one of parsers fails the function fails with that error.
+// Peek allows to assert that the next bytes match without consuming them.
+func Peek(noms ...Nom) Nom {
+	const funcName = "Peek"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		var (
+			rec  = recoveryOf(ctx)
+			mark = rec.mark()
+		)
+		for i, nom := range noms {
+			if err = nom(ctx, p); err != nil {
+				var nomErrPos, _ = p.TellPosition(ctx)
+				rec.rollback(mark)
+				_ = p.SeekPosition(ctx, startPos)
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, i), startPos, nomErrPos)
+			}
+		}
+
+		rec.rollback(mark)
+		if err = p.SeekPosition(ctx, startPos); err != nil {
+			return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, -1)
+		}
+
+		return
+	}
+}
+
+// Not runs all parsers noms and reverts back the read position in the plate regardless of the result.
+// If all parsers finished with success the function fails with ErrUnexpectedMatch, otherwise it succeeds.
+// Not allows to assert that the next bytes do not match without consuming them.
+// If a parser fails with ErrNeedMore or ErrCut the function fails with that error.
+func Not(noms ...Nom) Nom {
+	const funcName = "Not"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		var (
+			rec  = recoveryOf(ctx)
+			mark = rec.mark()
+		)
+		for i, nom := range noms {
+			if err = nom(ctx, p); err != nil {
+				rec.rollback(mark)
+				_ = p.SeekPosition(ctx, startPos)
+				if isFatal(err) {
+					return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, i), startPos, -1)
+				}
+				return nil
+			}
+		}
+
+		var endPos int
+		if endPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+		rec.rollback(mark)
+		if err = p.SeekPosition(ctx, startPos); err != nil {
+			return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, -1)
+		}
+
+		var e ErrUnexpectedMatch
+		if endPos > startPos {
+			if buf, sliceErr := p.ByteSlice(ctx, startPos, endPos); sliceErr == nil {
+				e.Have = make([]byte, len(buf))
+				copy(e.Have, buf)
+			}
+		}
+
+		return ExtendBreadcrumb(WrapBreadcrumb(e, funcName, -1), startPos, endPos)
+	}
+}
@@ -0,0 +1,103 @@
+package bynom
+
+import "context"
+
+// Many0 runs all parsers noms repeatedly zero or more times until at least one of them fails.
+// See ManyMN for details.
+func Many0(noms ...Nom) Nom {
+	return many("Many0", 0, -1, noms)
+}
+
+// Many1 runs all parsers noms repeatedly one or more times until at least one of them fails.
+// See ManyMN for details.
+func Many1(noms ...Nom) Nom {
+	return many("Many1", 1, -1, noms)
+}
+
+// ManyMN runs all parsers noms repeatedly at least min and at most max times. A negative max means no limit.
+// When at least one of parsers fails the function reverts back the read position
+// to where the failed iteration started and stops.
+// If less than min iterations succeeded the function reverts back the read position to where
+// it started and returns the error of the failed iteration. The index of the breadcrumb
+// contains the number of iterations succeeded.
+// If an iteration succeeds without consuming input the function returns ErrNoProgress.
+// If max is less than min the function fails with ErrRequirementNotMet after max iterations.
+// If a parser fails with ErrNeedMore or ErrCut the function fails with that error.
+func ManyMN(min, max int, noms ...Nom) Nom {
+	return many("ManyMN", min, max, noms)
+}
+
+func many(funcName string, min, max int, noms []Nom) Nom {
+	return func(ctx context.Context, p Plate) (err error) {
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		var (
+			rec   = recoveryOf(ctx)
+			count int
+		)
+		for max < 0 || count < max {
+			var mark = rec.mark()
+
+			var iterStartPos int
+			if iterStartPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, count)
+			}
+
+			for _, nom := range noms {
+				if err = nom(ctx, p); err != nil {
+					break
+				}
+			}
+			if err != nil {
+				var iterErrPos, _ = p.TellPosition(ctx)
+				err = ExtendBreadcrumb(WrapBreadcrumb(err, funcName, count), iterStartPos, iterErrPos)
+				if isFatal(err) {
+					return
+				}
+				if count < min {
+					_ = p.SeekPosition(ctx, startPos)
+					return
+				}
+				rec.rollback(mark)
+				if err = p.SeekPosition(ctx, iterStartPos); err != nil {
+					return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, count), iterStartPos, -1)
+				}
+				break
+			}
+
+			var iterEndPos int
+			if iterEndPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, count)
+			}
+			if iterEndPos == iterStartPos {
+				_ = p.SeekPosition(ctx, startPos)
+				return ExtendBreadcrumb(WrapBreadcrumb(ErrNoProgress, funcName, count), iterStartPos, iterEndPos)
+			}
+
+			count++
+		}
+		if count < min {
+			// The maximum is less than the minimum, so the minimum can not be reached.
+			var endPos, _ = p.TellPosition(ctx)
+			_ = p.SeekPosition(ctx, startPos)
+			return ExtendBreadcrumb(
+				WrapBreadcrumb(
+					ErrRequirementNotMet{
+						Expected: min,
+						Have:     count,
+						Msg:      "too few iterations",
+					},
+					funcName,
+					count,
+				),
+				startPos,
+				endPos,
+			)
+		}
+
+		return
+	}
+}
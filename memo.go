@@ -0,0 +1,91 @@
+package bynom
+
+import "context"
+
+type memoKey struct{}
+
+// memoRule identifies the parser made with Memo.
+type memoRule struct {
+	_ byte // Non-zero size guarantees distinct addresses.
+}
+
+type memoSlot struct {
+	rule *memoRule
+	pos  int
+}
+
+type memoEntry struct {
+	endPos int
+	err    error
+	errs   []error // Failures recorded by parsers made with Recover.
+}
+
+// memoCache stores outcomes of parsers made with Memo during one run of Bite.Eat.
+type memoCache struct {
+	plate Plate // The plate Bite.Eat runs on.
+	m     map[memoSlot]memoEntry
+}
+
+// withMemoCache returns the copy of ctx with the new empty memoization cache of outcomes on the plate p.
+func withMemoCache(ctx context.Context, p Plate) context.Context {
+	return context.WithValue(ctx, memoKey{}, &memoCache{plate: p})
+}
+
+// Memo memoizes the outcome of the parser nom, i.e. the error and the read position it finished with,
+// for each position it starts at. When the parser is run again at the same position the outcome
+// is taken from the cache without running nom. Failures recorded by parsers made with Recover
+// inside nom are part of the outcome, so they are recorded again.
+// The cache is scoped to one call of Bite.Eat, outside of Bite.Eat the function just runs nom.
+// Outcomes are cached only on the plate Bite.Eat runs on, inside bounded regions, e.g. Limit
+// or LengthPrefixed, positions are the same but outcomes differ, so there the function just runs nom.
+// Because nom is not run again its side effects are not repeated, so it must not contain
+// Take, ChangeState, Signal and other parsers with side effects.
+func Memo(nom Nom) Nom {
+	const funcName = "Memo"
+
+	var rule = new(memoRule)
+	return func(ctx context.Context, p Plate) (err error) {
+		var cache, _ = ctx.Value(memoKey{}).(*memoCache)
+		if cache == nil || p != cache.plate {
+			return nom(ctx, p)
+		}
+
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		var slot = memoSlot{rule: rule, pos: startPos}
+		if e, ok := cache.m[slot]; ok {
+			if err = p.SeekPosition(ctx, e.endPos); err != nil {
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, e.endPos)
+			}
+			if rec := recoveryOf(ctx); rec != nil {
+				rec.errs = append(rec.errs, e.errs...)
+			}
+			return e.err
+		}
+
+		var (
+			rec  = recoveryOf(ctx)
+			mark = rec.mark()
+		)
+		if err = nom(ctx, p); err != nil && isFatal(err) {
+			return
+		}
+
+		var endPos, posErr = p.TellPosition(ctx)
+		if posErr == nil {
+			if cache.m == nil {
+				cache.m = make(map[memoSlot]memoEntry)
+			}
+			var e = memoEntry{endPos: endPos, err: err}
+			if rec != nil && len(rec.errs) > mark {
+				e.errs = append([]error(nil), rec.errs[mark:]...)
+			}
+			cache.m[slot] = e
+		}
+
+		return
+	}
+}
@@ -0,0 +1,150 @@
+package bynom
+
+import "context"
+
+// Parser implements logic of how to read byte(s) from the plate and returns the value parsed.
+// Unlike Nom which passes results through converters and closures, Parser returns the result,
+// so grammars built with Parser do not need shared state and are safe for concurrent use.
+type Parser[T any] func(context.Context, Plate) (T, error)
+
+// PairOf holds the results of two parsers combined with Pair.
+type PairOf[T, U any] struct {
+	First  T
+	Second U
+}
+
+// Lift converts the parser nom into Parser which returns the bytes nom ate.
+// The byte slice returned is obtained with Plate.ByteSlice, so it may alias the plate buffer.
+func Lift(nom Nom) Parser[[]byte] {
+	const funcName = "Lift"
+
+	return func(ctx context.Context, p Plate) (v []byte, err error) {
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return nil, WrapBreadcrumb(err, funcName, -1)
+		}
+
+		if err = nom(ctx, p); err != nil {
+			var errPos, _ = p.TellPosition(ctx)
+			return nil, ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, errPos)
+		}
+
+		var endPos int
+		if endPos, err = p.TellPosition(ctx); err != nil {
+			return nil, WrapBreadcrumb(err, funcName, -1)
+		}
+		if endPos == startPos {
+			return nil, nil
+		}
+
+		if v, err = p.ByteSlice(ctx, startPos, endPos); err != nil {
+			return nil, WrapBreadcrumb(err, funcName, -1)
+		}
+
+		return
+	}
+}
+
+// Nom converts the parser into Nom which passes the value parsed to fn.
+// If fn is nil the value is discarded. If fn returns non-nil error the Nom fails with that error.
+func (pr Parser[T]) Nom(fn func(T) error) Nom {
+	const funcName = "Parser"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var v T
+		if v, err = pr(ctx, p); err != nil {
+			return err
+		}
+
+		if fn != nil {
+			if err = fn(v); err != nil {
+				return WrapBreadcrumb(err, funcName, -1)
+			}
+		}
+
+		return
+	}
+}
+
+// Map runs the parser pr and converts the value it returned with fn.
+// If fn returns non-nil error the function fails with that error.
+func Map[T, U any](pr Parser[T], fn func(T) (U, error)) Parser[U] {
+	const funcName = "Map"
+
+	return func(ctx context.Context, p Plate) (u U, err error) {
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return u, WrapBreadcrumb(err, funcName, -1)
+		}
+
+		var v T
+		if v, err = pr(ctx, p); err != nil {
+			return u, err
+		}
+
+		if u, err = fn(v); err != nil {
+			var endPos, _ = p.TellPosition(ctx)
+			return u, ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, endPos)
+		}
+
+		return
+	}
+}
+
+// Then runs the parser pr and then the parser fn makes from the value pr returned.
+// It allows the next step of parsing to depend on the value parsed before.
+func Then[T, U any](pr Parser[T], fn func(T) Parser[U]) Parser[U] {
+	const funcName = "Then"
+
+	return func(ctx context.Context, p Plate) (u U, err error) {
+		var v T
+		if v, err = pr(ctx, p); err != nil {
+			return u, WrapBreadcrumb(err, funcName, 0)
+		}
+
+		var nomStartPos int
+		if nomStartPos, err = p.TellPosition(ctx); err != nil {
+			return u, WrapBreadcrumb(err, funcName, 1)
+		}
+
+		if u, err = fn(v)(ctx, p); err != nil {
+			var nomErrPos, _ = p.TellPosition(ctx)
+			return u, ExtendBreadcrumb(WrapBreadcrumb(err, funcName, 1), nomStartPos, nomErrPos)
+		}
+
+		return
+	}
+}
+
+// Pair runs parsers a and b in sequence and returns both values.
+func Pair[T, U any](a Parser[T], b Parser[U]) Parser[PairOf[T, U]] {
+	const funcName = "Pair"
+
+	return func(ctx context.Context, p Plate) (v PairOf[T, U], err error) {
+		if v.First, err = a(ctx, p); err != nil {
+			return v, WrapBreadcrumb(err, funcName, 0)
+		}
+
+		var nomStartPos int
+		if nomStartPos, err = p.TellPosition(ctx); err != nil {
+			return v, WrapBreadcrumb(err, funcName, 1)
+		}
+
+		if v.Second, err = b(ctx, p); err != nil {
+			var nomErrPos, _ = p.TellPosition(ctx)
+			return v, ExtendBreadcrumb(WrapBreadcrumb(err, funcName, 1), nomStartPos, nomErrPos)
+		}
+
+		return
+	}
+}
+
+// Parse runs the parser pr over the plate p and returns the value parsed.
+// Parsing is performed in the same transactional manner as Bite.Eat does.
+func Parse[T any](ctx context.Context, p Plate, pr Parser[T]) (v T, err error) {
+	err = NewBite(pr.Nom(func(t T) error {
+		v = t
+		return nil
+	})).Eat(ctx, p)
+	return
+}
@@ -21,6 +21,15 @@ func (hf *HexFormatter) Format(w io.Writer, e error) (err error) {
 	switch v := e.(type) {
 	case *bynom.ErrParseFailed:
 		err = hf.formatParseError(w, v)
+	case *bynom.ErrParseFailures:
+		for i, e := range v.Errs {
+			if i > 0 && err == nil {
+				_, err = w.Write([]byte{'\n'})
+			}
+			if err == nil {
+				err = hf.Format(w, e)
+			}
+		}
 	default:
 		err = hf.formatGenericError(w, e)
 	}
@@ -61,9 +70,22 @@ func (hf *HexFormatter) formatParseError(w io.Writer, e *bynom.ErrParseFailed) (
 
 	put("Error:")
 	put(indent, e.Err.Error())
+
+	if v, ok := e.Err.(bynom.ErrExpectedOneOf); ok {
+		put("Expected one of:")
+		for _, expected := range v.Expected {
+			put(indent, expected)
+		}
+	}
+
 	put("Range:")
 	put(indent, "start=", strconv.Itoa(e.StartPos), ", end=", strconv.Itoa(e.EndPos))
 
+	if e.Line > 0 {
+		put("Location:")
+		put(indent, "line=", strconv.Itoa(e.Line), ", column=", strconv.Itoa(e.Column))
+	}
+
 	if e.Context != nil {
 		put("Context:")
 		if e.Context.Parted {
@@ -0,0 +1,108 @@
+package bynom
+
+import (
+	"context"
+	"io"
+)
+
+type recoveryKey struct{}
+
+// recovery collects failures parsers made with Recover recovered from during one call of Bite.Eat.
+type recovery struct {
+	bite *Bite
+	errs []error
+}
+
+// withRecovery returns the copy of ctx with the failures collector rec.
+func withRecovery(ctx context.Context, rec *recovery) context.Context {
+	return context.WithValue(ctx, recoveryKey{}, rec)
+}
+
+// recoveryOf returns the failures collector of ctx or nil if parsing runs outside of Bite.Eat.
+func recoveryOf(ctx context.Context) *recovery {
+	var rec, _ = ctx.Value(recoveryKey{}).(*recovery)
+	return rec
+}
+
+// mark returns the amount of failures recorded so far.
+// Parsers which backtrack take the mark before trying the branch, see rollback.
+func (rec *recovery) mark() int {
+	if rec == nil {
+		return 0
+	}
+	return len(rec.errs)
+}
+
+// rollback drops failures recorded after the mark was taken. Parsers call it when they revert
+// the read position, so failures recorded on the branch abandoned are not reported.
+func (rec *recovery) rollback(mark int) {
+	if rec != nil && len(rec.errs) > mark {
+		rec.errs = rec.errs[:mark]
+	}
+}
+
+// Recover runs the parser nom and if it fails records the failure and skips bytes until
+// the parser syncTo succeeds, e.g. at the next newline or ';', or io.EOF encountered.
+// The search for the synchronization point starts at the position nom started at,
+// and the synchronization point is consumed. After that the function succeeds, so parsing
+// continues and Bite.Eat returns ErrParseFailures containing all failures recorded.
+// If nom failed at the end of the plate, so nothing can be skipped, the function fails with that error.
+// Failures recorded on branches abandoned later, e.g. by Switch, Optional or Many0, are dropped.
+// Outside of Bite.Eat the function just runs nom.
+// Failures of Cut are recovered too, so grammars using Cut still collect all failures.
+// If nom fails with ErrNeedMore or ErrMaxDepthExceeded the function fails with that error.
+func Recover(nom Nom, syncTo Nom) Nom {
+	const funcName = "Recover"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var rec = recoveryOf(ctx)
+		if rec == nil {
+			return nom(ctx, p)
+		}
+
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		var (
+			mark   = rec.mark()
+			nomErr error
+		)
+		if nomErr = nom(ctx, p); nomErr == nil || isUnrecoverable(nomErr) {
+			return nomErr
+		}
+		rec.rollback(mark)
+
+		var errPos, _ = p.TellPosition(ctx)
+		for pos := startPos; ; pos++ {
+			if err = p.SeekPosition(ctx, pos); err != nil {
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, pos)
+			}
+			if err = syncTo(ctx, p); err == nil {
+				break
+			}
+			if isUnrecoverable(err) {
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, pos)
+			}
+			rec.rollback(mark)
+
+			if err = p.SeekPosition(ctx, pos); err != nil {
+				return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, pos)
+			}
+			if _, err = p.NextByte(ctx); err != nil {
+				if err != io.EOF {
+					return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, pos)
+				}
+				if pos == startPos {
+					return nomErr
+				}
+				err = nil
+				break
+			}
+		}
+
+		rec.errs = append(rec.errs, rec.bite.parseFailed(ctx, p, nomErr, startPos, errPos))
+		return
+	}
+}
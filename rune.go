@@ -0,0 +1,93 @@
+package bynom
+
+import (
+	"context"
+	"io"
+	"unicode/utf8"
+)
+
+// NextRune reads the next UTF-8 encoded rune from the plate and returns it with its size in bytes.
+// If the bytes read do not form a valid UTF-8 sequence the function returns ErrInvalidUTF8
+// and the read position is left unchanged.
+//
+// When no bytes left the function returns io.EOF. If the plate ends in the middle
+// of the rune the function returns io.ErrUnexpectedEOF.
+func NextRune(ctx context.Context, p Plate) (r rune, size int, err error) {
+	var startPos int
+	if startPos, err = p.TellPosition(ctx); err != nil {
+		return
+	}
+
+	var buf [utf8.UTFMax]byte
+	if buf[0], err = p.NextByte(ctx); err != nil {
+		return
+	}
+	if buf[0] < utf8.RuneSelf {
+		return rune(buf[0]), 1, nil
+	}
+
+	var l = runeLen(buf[0])
+	for size = 1; size < l; size++ {
+		if buf[size], err = p.NextByte(ctx); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			break
+		}
+		if !utf8.RuneStart(buf[size]) {
+			continue
+		}
+
+		// The byte starts a new rune, so the sequence is invalid.
+		size++
+		break
+	}
+	if err == nil {
+		if r, size = utf8.DecodeRune(buf[:size]); r != utf8.RuneError || size > 1 {
+			return
+		}
+	}
+
+	_ = p.SeekPosition(ctx, startPos)
+	if err == nil {
+		err = ErrInvalidUTF8{
+			Pos:  startPos,
+			Have: buf[0],
+		}
+	}
+
+	return 0, 0, err
+}
+
+// PeekRune returns the UTF-8 encoded rune at the current read position with its size in bytes.
+// The function does not affect the plate read position.
+func PeekRune(ctx context.Context, p Plate) (r rune, size int, err error) {
+	var startPos int
+	if startPos, err = p.TellPosition(ctx); err != nil {
+		return
+	}
+
+	if r, size, err = NextRune(ctx, p); err != nil {
+		return
+	}
+	if err = p.SeekPosition(ctx, startPos); err != nil {
+		return 0, 0, err
+	}
+
+	return
+}
+
+// runeLen returns the length of UTF-8 sequence starting with the byte b.
+// The function returns 1 for bytes which can not start a multibyte sequence.
+func runeLen(b byte) int {
+	switch {
+	case b&0xE0 == 0xC0:
+		return 2
+	case b&0xF0 == 0xE0:
+		return 3
+	case b&0xF8 == 0xF0:
+		return 4
+	default:
+		return 1
+	}
+}
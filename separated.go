@@ -0,0 +1,105 @@
+package bynom
+
+import "context"
+
+// Trailing defines how separated lists treat the separator after the last item.
+type Trailing int
+
+const (
+	TrailingNone    Trailing = iota // The separator after the last item is not parsed.
+	TrailingAllow                   // The separator after the last item is parsed if present.
+	TrailingRequire                 // The separator after the last item is required.
+)
+
+// SeparatedList0 parses zero or more items with the parser item separated with the parser sep.
+// See SeparatedList1 for details.
+func SeparatedList0(sep, item Nom, trailing Trailing) Nom {
+	return separatedList("SeparatedList0", 0, sep, item, trailing)
+}
+
+// SeparatedList1 parses one or more items with the parser item separated with the parser sep.
+// The list finishes when the separator or the item after the separator fails, the read position
+// is reverted back to the end of the list. The separator after the last item is treated according to trailing.
+// If the list does not contain enough items or the required trailing separator is missing the function
+// reverts back the read position in the plate and fails. The index of the breadcrumb contains the index
+// of the item failed.
+// If a parser fails with ErrNeedMore or ErrCut the function fails with that error.
+func SeparatedList1(sep, item Nom, trailing Trailing) Nom {
+	return separatedList("SeparatedList1", 1, sep, item, trailing)
+}
+
+func separatedList(funcName string, min int, sep, item Nom, trailing Trailing) Nom {
+	return func(ctx context.Context, p Plate) (err error) {
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		var rec = recoveryOf(ctx)
+		for count := 0; ; count++ {
+			var sepMark = rec.mark()
+
+			var sepStartPos int
+			if sepStartPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, count)
+			}
+
+			if count > 0 {
+				if err = sep(ctx, p); err != nil {
+					var sepErrPos, _ = p.TellPosition(ctx)
+					err = ExtendBreadcrumb(WrapBreadcrumb(err, funcName, count-1), sepStartPos, sepErrPos)
+					if isFatal(err) {
+						return
+					}
+					if trailing == TrailingRequire {
+						_ = p.SeekPosition(ctx, startPos)
+						return
+					}
+					rec.rollback(sepMark)
+					if err = p.SeekPosition(ctx, sepStartPos); err != nil {
+						return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, count), sepStartPos, -1)
+					}
+					return
+				}
+			}
+
+			var itemStartPos int
+			if itemStartPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, count)
+			}
+			var itemMark = rec.mark()
+
+			if err = item(ctx, p); err != nil {
+				var itemErrPos, _ = p.TellPosition(ctx)
+				err = ExtendBreadcrumb(WrapBreadcrumb(err, funcName, count), itemStartPos, itemErrPos)
+				if isFatal(err) {
+					return
+				}
+				if count < min {
+					_ = p.SeekPosition(ctx, startPos)
+					return
+				}
+
+				// Keep the separator parsed if the trailing separator is allowed.
+				var endPos, mark = sepStartPos, sepMark
+				if trailing != TrailingNone {
+					endPos, mark = itemStartPos, itemMark
+				}
+				rec.rollback(mark)
+				if err = p.SeekPosition(ctx, endPos); err != nil {
+					return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, count), endPos, -1)
+				}
+				return
+			}
+
+			var itemEndPos int
+			if itemEndPos, err = p.TellPosition(ctx); err != nil {
+				return WrapBreadcrumb(err, funcName, count)
+			}
+			if itemEndPos == sepStartPos {
+				_ = p.SeekPosition(ctx, startPos)
+				return ExtendBreadcrumb(WrapBreadcrumb(ErrNoProgress, funcName, count), sepStartPos, itemEndPos)
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package span
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// UnicodeRange accepts UTF-8 encoded runes which belong to the Unicode range table.
+// UnicodeRange keeps the bytes of the rune being tested, so the same instance must not be
+// used by parsers running concurrently. Grammars shared across goroutines should use
+// WhileRune(func(r rune) bool { return unicode.Is(table, r) }) instead, which keeps no state.
+type UnicodeRange struct {
+	table *unicode.RangeTable
+	buf   [utf8.UTFMax]byte
+	l     int // The length of the rune being tested.
+}
+
+// Unicode creates a range which includes all runes from the Unicode range table.
+func Unicode(table *unicode.RangeTable) *UnicodeRange {
+	return &UnicodeRange{
+		table: table,
+	}
+}
+
+// IsAcceptable tests if the n-th byte of the rune is valid and the rune belongs to the table.
+func (u *UnicodeRange) IsAcceptable(n int, v byte) (bool, int) {
+	var r, left, ok = u.decode(n, v)
+	if !ok {
+		return false, -1
+	}
+	if left > 0 {
+		return true, left
+	}
+	return unicode.Is(u.table, r), 0
+}
+
+// IsIneligible tests if the n-th byte of the rune is valid and the rune does not belong to the table.
+func (u *UnicodeRange) IsIneligible(n int, v byte) (bool, int) {
+	var r, left, ok = u.decode(n, v)
+	if !ok {
+		return false, -1
+	}
+	if left > 0 {
+		return true, left
+	}
+	return !unicode.Is(u.table, r), 0
+}
+
+// Implement fmt.Stringer interface.
+func (u *UnicodeRange) String() string {
+	return "[unicode]"
+}
+
+// decode stores the n-th byte v of the rune and decodes the rune when all its bytes stored.
+// The function returns the rune decoded, the amount of bytes left to complete the rune
+// and the flag which designates if the byte v is valid in the n-th position.
+func (u *UnicodeRange) decode(n int, v byte) (r rune, left int, ok bool) {
+	if n == 0 {
+		switch {
+		case v < utf8.RuneSelf:
+			u.l = 1
+		case v&0xE0 == 0xC0:
+			u.l = 2
+		case v&0xF0 == 0xE0:
+			u.l = 3
+		case v&0xF8 == 0xF0:
+			u.l = 4
+		default:
+			return 0, 0, false
+		}
+	} else if n >= u.l || utf8.RuneStart(v) {
+		return 0, 0, false
+	}
+
+	u.buf[n] = v
+	if left = u.l - n - 1; left > 0 {
+		return 0, left, true
+	}
+
+	var size int
+	if r, size = utf8.DecodeRune(u.buf[:u.l]); r == utf8.RuneError && size <= 1 {
+		return 0, 0, false
+	}
+
+	return r, 0, true
+}
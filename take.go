@@ -39,7 +39,7 @@ func Take(fn Convert, noms ...Nom) Nom {
 			return WrapBreadcrumb(err, funcName, -1)
 		}
 		if err = fn(s); err != nil {
-			return WrapBreadcrumb(err, funcName, -1)
+			return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, endPos)
 		}
 
 		return
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+)
+
+func TestBinary_Eat(t *testing.T) {
+	var (
+		u16 uint16
+		u32 uint32
+		i64 int64
+		f32 float32
+		r   = NewBite(U16BE(&u16), U32LE(&u32), I64BE(&i64), F32LE(&f32))
+		p   = dish.NewBytes([]byte{
+			0x12, 0x34,
+			0x78, 0x56, 0x34, 0x12,
+			0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE,
+			0x00, 0x00, 0xC0, 0x3F,
+		})
+	)
+
+	if err := r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if u16 != 0x1234 || u32 != 0x12345678 || i64 != -2 || f32 != 1.5 {
+		t.Fatalf("Unexpected values %x %x %d %f\n", u16, u32, i64, f32)
+	}
+
+	var err = NewBite(U32BE(&u32)).Eat(context.Background(), dish.NewBytes([]byte{1, 2}))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected io.ErrUnexpectedEOF, have %v\n", err)
+	}
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "U32BE" {
+		t.Fatalf("Expected U32BE in stack, have %v\n", err)
+	}
+}
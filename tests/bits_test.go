@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+)
+
+func TestBits_MSBFirst(t *testing.T) {
+	var (
+		version, ihl, dscp uint64
+		r                  = NewBite(Bits(MSBFirst, TakeBits(4, &version), TakeBits(4, &ihl), TakeBits(6, &dscp)), Expect('!'))
+		p                  = dish.NewBytes([]byte{0x45, 0xB8, '!'})
+	)
+
+	if err := r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if version != 4 || ihl != 5 || dscp != 46 {
+		t.Fatalf("Unexpected values %d %d %d\n", version, ihl, dscp)
+	}
+}
+
+func TestBits_LSBFirst(t *testing.T) {
+	var (
+		final, kind, wide uint64
+		r                 = NewBite(Bits(LSBFirst, TakeBits(1, &final), TakeBits(2, &kind), TakeBits(12, &wide)))
+		p                 = dish.NewBytes([]byte{0b10101101, 0b01110011})
+	)
+
+	if err := r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if final != 1 || kind != 2 || wide != 0b111001110101 {
+		t.Fatalf("Unexpected values %b %b %b\n", final, kind, wide)
+	}
+}
+
+func TestBits_Expect(t *testing.T) {
+	var r = NewBite(Bits(MSBFirst, ExpectBits(3, 0b101), ExpectBits(5, 0b00001)))
+	if err := r.Eat(context.Background(), dish.NewBytes([]byte{0b10100001})); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+
+	var (
+		err = r.Eat(context.Background(), dish.NewBytes([]byte{0b10100011}))
+		bef ErrBitsExpectationFailed
+	)
+	if !errors.As(err, &bef) || bef.Expected != 1 || bef.Have != 3 {
+		t.Fatalf("Expected ErrBitsExpectationFailed, have %v\n", err)
+	}
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "Bits" || pf.Stack[0].Index != 1 {
+		t.Fatalf("Expected bit parser 1 to fail, have %v\n", err)
+	}
+
+	var v uint64
+	err = NewBite(Bits(MSBFirst, TakeBits(12, &v))).Eat(context.Background(), dish.NewBytes([]byte{0xFF}))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("Expected io.ErrUnexpectedEOF, have %v\n", err)
+	}
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/span"
+)
+
+func TestCut_Eat(t *testing.T) {
+	var (
+		digits = WhileAcceptable(span.Range('0', '9'))
+		r      = NewBite(
+			Many0(
+				Switch(
+					Sequence(Expect('{'), Cut(digits, Expect('}'))),
+					Sequence(Expect('['), Cut(digits, Expect(']'))),
+				),
+			),
+		)
+	)
+
+	if err := r.Eat(context.Background(), dish.NewString("{1}[2]")); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+
+	var err = r.Eat(context.Background(), dish.NewString("{1}{2]"))
+	var e, ok = err.(*ErrParseFailed)
+	if !ok {
+		t.Fatalf("Expected ErrParseFailed, have %v\n", err)
+	}
+	if ee, ok := e.Err.(ErrExpectationFailed); !ok || ee.Expected != byte('}') {
+		t.Fatalf("Expected failed expectation of '}', have %v\n", e.Err)
+	}
+	if e.EndPos != 6 {
+		t.Fatalf("Expected end position 6, have %d\n", e.EndPos)
+	}
+}
@@ -0,0 +1,307 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/into"
+	"github.com/workanator/bynom/span"
+)
+
+func TestReader_Eat(t *testing.T) {
+	const pattern = "key = value"
+
+	var (
+		p          = dish.NewReaderSize(iotest.OneByteReader(strings.NewReader(pattern)), 2)
+		key, value []byte
+		whitespace = span.Set(' ', '\t')
+	)
+
+	var r = NewBite(
+		Take(into.Bytes(&key), WhileAcceptable(span.Range('a', 'z'))),
+		Optional(WhileAcceptable(whitespace)),
+		Expect('='),
+		Optional(WhileAcceptable(whitespace)),
+		Take(into.Bytes(&value), Any()),
+	)
+
+	var err error
+	if err = r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+
+	if string(key) != "key" {
+		t.Fatalf("Expected key %s, have %s\n", "key", string(key))
+	}
+	if string(value) != "value" {
+		t.Fatalf("Expected value %s, have %s\n", "value", string(value))
+	}
+}
+
+func TestReader_Commit(t *testing.T) {
+	var (
+		p    = dish.NewReaderSize(strings.NewReader("a;bb;ccc;"), 2)
+		word []byte
+		r    = NewBite(
+			Take(into.Bytes(&word), WhileNot(';')),
+			Expect(';'),
+		)
+		ctx = context.Background()
+	)
+
+	for _, expected := range []string{"a", "bb", "ccc"} {
+		if err := r.Eat(ctx, p); err != nil {
+			t.Fatalf("Failed to eat: %v\n", err)
+		}
+		if string(word) != expected {
+			t.Fatalf("Expected word %s, have %s\n", expected, string(word))
+		}
+		if err := p.SeekPosition(ctx, 0); err == nil {
+			t.Fatal("Expected committed position to be unreachable")
+		}
+	}
+}
+
+func TestReader_NestedCommit(t *testing.T) {
+	var (
+		p      = dish.NewReaderSize(strings.NewReader("ab"), 1)
+		nested = NewBite(Expect('a'))
+		r      = NewBite(
+			Switch(
+				Sequence(nested.Eat, Expect('x')),
+				Sequence(Expect('a'), Expect('b')),
+			),
+		)
+	)
+
+	if err := r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+}
+
+func TestBuffer_Eat(t *testing.T) {
+	var (
+		p    = dish.NewBuffer()
+		word []byte
+		r    = NewBite(
+			Take(into.Bytes(&word), WhileNot(';')),
+			Expect(';'),
+		)
+		ctx = context.Background()
+	)
+
+	for _, chunk := range []string{"he", "llo"} {
+		_, _ = p.Write([]byte(chunk))
+		if err := r.Eat(ctx, p); err != ErrNeedMore {
+			t.Fatalf("Expected ErrNeedMore, have %v\n", err)
+		}
+	}
+
+	_, _ = p.Write([]byte(";"))
+	if err := r.Eat(ctx, p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if string(word) != "hello" {
+		t.Fatalf("Expected word %s, have %s\n", "hello", string(word))
+	}
+}
+
+func TestFile_Eat(t *testing.T) {
+	const pattern = "key = value"
+
+	var name = filepath.Join(t.TempDir(), "pattern")
+	if err := os.WriteFile(name, []byte(pattern), 0600); err != nil {
+		t.Fatalf("Failed to write file: %v\n", err)
+	}
+
+	var p, err = dish.OpenFile(name)
+	if err != nil {
+		t.Fatalf("Failed to open file: %v\n", err)
+	}
+	defer p.Close()
+
+	var (
+		key, value []byte
+		r          = NewBite(
+			Take(into.Bytes(&key), WhileNot(' ')),
+			Expect(' '),
+			Expect('='),
+			Expect(' '),
+			Take(into.Bytes(&value), Any()),
+		)
+	)
+	if err = r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+
+	if string(key) != "key" {
+		t.Fatalf("Expected key %s, have %s\n", "key", string(key))
+	}
+	if string(value) != "value" {
+		t.Fatalf("Expected value %s, have %s\n", "value", string(value))
+	}
+}
+
+func TestFile_ReaderAt(t *testing.T) {
+	const pattern = "key = value"
+
+	var p, err = dish.NewReaderAtSize(strings.NewReader(pattern), int64(len(pattern)), 4)
+	if err != nil {
+		t.Fatalf("Failed to make plate: %v\n", err)
+	}
+
+	var (
+		key, value []byte
+		r          = NewBite(
+			Take(into.Bytes(&key), WhileNot(' ')),
+			Expect(' '),
+			Expect('='),
+			Expect(' '),
+			Take(into.Bytes(&value), Any()),
+		)
+	)
+	if err = r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+
+	if string(key) != "key" {
+		t.Fatalf("Expected key %s, have %s\n", "key", string(key))
+	}
+	if string(value) != "value" {
+		t.Fatalf("Expected value %s, have %s\n", "value", string(value))
+	}
+
+	var s []byte
+	if s, err = p.ByteSlice(context.Background(), 2, 9); err != nil {
+		t.Fatalf("Failed to slice across windows: %v\n", err)
+	}
+	if string(s) != "y = val" {
+		t.Fatalf("Expected slice %s, have %s\n", "y = val", string(s))
+	}
+}
+
+func TestFile_ReaderAtWindowReuse(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		p, err = dish.NewReaderAtSize(strings.NewReader("0123456789"), 10, 4)
+	)
+	if err != nil {
+		t.Fatalf("Failed to make plate: %v\n", err)
+	}
+
+	var backtrack = func() {
+		for _, pos := range []int{3, 4} {
+			_ = p.SeekPosition(ctx, pos)
+			if _, err := p.NextByte(ctx); err != nil {
+				t.Fatalf("Failed to read at %d: %v\n", pos, err)
+			}
+		}
+	}
+	backtrack()
+	if allocs := testing.AllocsPerRun(100, backtrack); allocs != 0 {
+		t.Fatalf("Expected backtracking across the window boundary not to allocate, have %v allocations\n", allocs)
+	}
+}
+
+func TestFile_ReaderAtShortRead(t *testing.T) {
+	var p, err = dish.NewReaderAtSize(strings.NewReader("0123"), 10, 4)
+	if err != nil {
+		t.Fatalf("Failed to make plate: %v\n", err)
+	}
+
+	if _, err = p.ByteSlice(context.Background(), 2, 8); err != io.ErrUnexpectedEOF {
+		t.Fatalf("Expected io.ErrUnexpectedEOF, have %v\n", err)
+	}
+}
+
+func TestSegments_Eat(t *testing.T) {
+	var (
+		p          = dish.NewSegments([]byte("ke"), []byte("y = va"), nil, []byte("lue"))
+		key, value []byte
+		r          = NewBite(
+			Take(into.Bytes(&key), WhileNot(' ')),
+			Expect(' '),
+			Expect('='),
+			Expect(' '),
+			Take(into.Bytes(&value), Any()),
+		)
+	)
+	if err := r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+
+	if string(key) != "key" {
+		t.Fatalf("Expected key %s, have %s\n", "key", string(key))
+	}
+	if string(value) != "value" {
+		t.Fatalf("Expected value %s, have %s\n", "value", string(value))
+	}
+}
+
+func TestLines_Eat(t *testing.T) {
+	var (
+		p    = dish.NewLines(dish.NewString("a=1\nb=2\nc:3\n"))
+		line = Sequence(
+			WhileAcceptable(span.Range('a', 'z')),
+			Expect('='),
+			WhileAcceptable(span.Range('0', '9')),
+			Expect('\n'),
+		)
+		r = NewBite(line, line, line)
+	)
+
+	var err = r.Eat(context.Background(), p)
+	if err == nil {
+		t.Fatal("Expected parse error")
+	}
+
+	var e, ok = err.(*ErrParseFailed)
+	if !ok {
+		t.Fatalf("Expected ErrParseFailed, have %v\n", err)
+	}
+	if e.Line != 3 || e.Column != 3 {
+		t.Fatalf("Expected line 3 and column 3, have line %d and column %d\n", e.Line, e.Column)
+	}
+}
+
+func TestPlates_SeekEnd(t *testing.T) {
+	const pattern = "key"
+
+	var buf = dish.NewBuffer()
+	_, _ = buf.Write([]byte(pattern))
+	_ = buf.Close()
+
+	var file, err = dish.NewReaderAt(strings.NewReader(pattern), int64(len(pattern)))
+	if err != nil {
+		t.Fatalf("Failed to make plate: %v\n", err)
+	}
+
+	var plates = map[string]Plate{
+		"Bytes":    dish.NewBytes([]byte(pattern)),
+		"String":   dish.NewString(pattern),
+		"Reader":   dish.NewReader(strings.NewReader(pattern)),
+		"Buffer":   buf,
+		"File":     file,
+		"Segments": dish.NewSegments([]byte("k"), []byte("ey")),
+		"Window":   dish.NewWindow(dish.NewBytes([]byte(pattern+"!")), 0, len(pattern)),
+	}
+	for name, p := range plates {
+		var ctx = context.Background()
+		if err = p.SeekPosition(ctx, len(pattern)); err != nil {
+			t.Fatalf("%s: failed to seek to the end: %v\n", name, err)
+		}
+		if _, err = p.NextByte(ctx); err != io.EOF {
+			t.Fatalf("%s: expected io.EOF at the end, have %v\n", name, err)
+		}
+		if err = p.SeekPosition(ctx, len(pattern)+1); err == nil {
+			t.Fatalf("%s: expected seek beyond the end to fail\n", name)
+		}
+	}
+}
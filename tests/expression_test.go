@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/span"
+)
+
+func TestExpression_Parse(t *testing.T) {
+	var (
+		expr   = NewExpression[int]()
+		number = Map(Lift(WhileAcceptable(span.Range('0', '9'))), func(b []byte) (int, error) {
+			return strconv.Atoi(string(b))
+		})
+		group = Map(Pair(Lift(Expect('(')), Pair(expr.Parser(), Lift(Expect(')')))), func(v PairOf[[]byte, PairOf[int, []byte]]) (int, error) {
+			return v.Second.First, nil
+		})
+	)
+	expr.
+		Atom(func(ctx context.Context, p Plate) (int, error) {
+			if v, err := number(ctx, p); err == nil {
+				return v, nil
+			}
+			return group(ctx, p)
+		}).
+		Prefix(Expect('-'), 3, func(a int) (int, error) { return -a, nil }).
+		Infix(Expect('+'), 1, AssocLeft, func(a, b int) (int, error) { return a + b, nil }).
+		Infix(Expect('-'), 1, AssocLeft, func(a, b int) (int, error) { return a - b, nil }).
+		Infix(Expect('*'), 2, AssocLeft, func(a, b int) (int, error) { return a * b, nil }).
+		Infix(Expect('^'), 4, AssocRight, func(a, b int) (int, error) {
+			var v = 1
+			for i := 0; i < b; i++ {
+				v *= a
+			}
+			return v, nil
+		}).
+		Postfix(Expect('!'), 5, func(a int) (int, error) {
+			var v = 1
+			for i := 2; i <= a; i++ {
+				v *= i
+			}
+			return v, nil
+		})
+
+	for pattern, expected := range map[string]int{
+		"1+2*3-4":  3,
+		"10-4-3":   3,
+		"2^3^2":    512,
+		"-(1+2)*3": -9,
+		"2*3!":     12,
+	} {
+		var v, err = Parse(context.Background(), dish.NewString(pattern), expr.Parser())
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v\n", pattern, err)
+		}
+		if v != expected {
+			t.Fatalf("Expected %s = %d, have %d\n", pattern, expected, v)
+		}
+	}
+
+	var _, err = Parse(context.Background(), dish.NewString("1+"), expr.Parser())
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "Infix" || pf.Stack[0].Index != 0 {
+		t.Fatalf("Expected failure of the operand of Infix[0], have %v\n", err)
+	}
+}
+
+func TestExpression_NoAtom(t *testing.T) {
+	var _, err = Parse(context.Background(), dish.NewString("1"), NewExpression[int]().Parser())
+	if err == nil {
+		t.Fatalf("Expected error when the atom is not set\n")
+	}
+}
+
+func TestExpression_NoProgress(t *testing.T) {
+	var (
+		number = Map(Lift(WhileAcceptable(span.Range('0', '9'))), func(b []byte) (int, error) {
+			return strconv.Atoi(string(b))
+		})
+		expr = NewExpression[int]().
+			Atom(number).
+			Postfix(Optional(Expect('!')), 1, func(a int) (int, error) { return a, nil })
+	)
+
+	var _, err = Parse(context.Background(), dish.NewString("1"), expr.Parser())
+	if !errors.Is(err, ErrNoProgress) {
+		t.Fatalf("Expected ErrNoProgress, have %v\n", err)
+	}
+}
+
+func TestExpression_NegativePrecedence(t *testing.T) {
+	var (
+		number = Map(Lift(WhileAcceptable(span.Range('0', '9'))), func(b []byte) (int, error) {
+			return strconv.Atoi(string(b))
+		})
+		expr = NewExpression[int]().
+			Atom(number).
+			Infix(Expect('+'), -2, AssocLeft, func(a, b int) (int, error) { return a + b, nil }).
+			Infix(Expect('*'), -1, AssocLeft, func(a, b int) (int, error) { return a * b, nil })
+	)
+
+	var v, err = Parse(context.Background(), dish.NewString("1+2*3"), expr.Parser())
+	if err != nil || v != 7 {
+		t.Fatalf("Expected 7, have %d, %v\n", v, err)
+	}
+}
@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/into"
+	"github.com/workanator/bynom/span"
+)
+
+func TestFeast(t *testing.T) {
+	var (
+		f        = dish.NewFrames(strings.NewReader("one\ntwo\n3\nfour"), '\n')
+		word     []byte
+		words    []string
+		failures []int
+		r        = NewBite(
+			Take(into.Bytes(&word), WhileAcceptable(span.Range('a', 'z'))),
+			Signal(nil, func(context.Context, interface{}) error {
+				words = append(words, string(word))
+				return nil
+			}),
+		)
+	)
+
+	var err = Feast(context.Background(), f, r.Eat, func(i int, err error) error {
+		failures = append(failures, i)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to feast: %v\n", err)
+	}
+
+	if strings.Join(words, ",") != "one,two,four" {
+		t.Fatalf("Expected words one,two,four, have %v\n", words)
+	}
+	if len(failures) != 1 || failures[0] != 2 {
+		t.Fatalf("Expected failure of frame 2, have %v\n", failures)
+	}
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+)
+
+func TestLengthPrefixed(t *testing.T) {
+	var (
+		body []byte
+		take = func(b []byte) error { body = b; return nil }
+		r    = NewBite(LengthPrefixed(IntOf(U16BE), Take(take, Any())), Expect('!'))
+		p    = dish.NewBytes([]byte{0x00, 0x03, 'a', 'b', 'c', '!'})
+	)
+
+	if err := r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if !bytes.Equal(body, []byte("abc")) {
+		t.Fatalf("Expected abc, have %q\n", body)
+	}
+}
+
+func TestLengthPrefixed_UnderConsumed(t *testing.T) {
+	var (
+		r   = NewBite(LengthPrefixed(IntOf(Uvarint), Expect('a')))
+		err = r.Eat(context.Background(), dish.NewBytes([]byte{0x02, 'a', 'b'}))
+	)
+
+	var rnm ErrRequirementNotMet
+	if !errors.As(err, &rnm) || rnm.Expected != 2 || rnm.Have != 1 {
+		t.Fatalf("Expected ErrRequirementNotMet, have %v\n", err)
+	}
+}
+
+func TestLengthPrefixed_OverConsumed(t *testing.T) {
+	var (
+		r   = NewBite(LengthPrefixed(IntOf(U8), Sequence(Expect('a'), Expect('b'))))
+		err = r.Eat(context.Background(), dish.NewBytes([]byte{0x01, 'a', 'b'}))
+	)
+
+	if !errors.Is(err, io.EOF) {
+		t.Fatalf("Expected io.EOF, have %v\n", err)
+	}
+}
+
+func TestCountPrefixed(t *testing.T) {
+	var (
+		items [][]byte
+		take  = func(b []byte) error { items = append(items, b); return nil }
+		item  = LengthPrefixed(IntOf(U8), Take(take, Any()))
+		r     = NewBite(CountPrefixed(IntOf(U8), item))
+		p     = dish.NewBytes([]byte{0x02, 0x01, 'a', 0x02, 'b', 'c'})
+	)
+
+	if err := r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if len(items) != 2 || string(items[0]) != "a" || string(items[1]) != "bc" {
+		t.Fatalf("Unexpected items %q\n", items)
+	}
+
+	var err = NewBite(CountPrefixed(IntOf(U8), Expect('a'))).Eat(context.Background(), dish.NewBytes([]byte{0x02, 'a', 'b'}))
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "CountPrefixed" || pf.Stack[0].Index != 2 {
+		t.Fatalf("Expected item 1 to fail, have %v\n", err)
+	}
+}
+
+func TestIntOf_OutOfRange(t *testing.T) {
+	var err = NewBite(LengthPrefixed(IntOf(I8), Any())).Eat(context.Background(), dish.NewBytes([]byte{0xFF}))
+
+	var rnm ErrRequirementNotMet
+	if !errors.As(err, &rnm) {
+		t.Fatalf("Expected ErrRequirementNotMet, have %v\n", err)
+	}
+}
+
+func TestCountPrefixed_NoProgress(t *testing.T) {
+	var (
+		r   = NewBite(CountPrefixed(IntOf(Uvarint), Optional(Expect('a'))))
+		err = r.Eat(context.Background(), dish.NewBytes([]byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x40}))
+	)
+
+	if !errors.Is(err, ErrNoProgress) {
+		t.Fatalf("Expected ErrNoProgress, have %v\n", err)
+	}
+}
+
+func TestFraming_PrefixIndex(t *testing.T) {
+	for name, nom := range map[string]Nom{
+		"LengthPrefixed": LengthPrefixed(IntOf(U16BE), Any()),
+		"CountPrefixed":  CountPrefixed(IntOf(U16BE), Any()),
+	} {
+		var err = NewBite(nom).Eat(context.Background(), dish.NewBytes([]byte{0x01}))
+		if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != name || pf.Stack[0].Index != 0 {
+			t.Fatalf("%s: expected the prefix failure at index 0, have %v\n", name, err)
+		}
+	}
+}
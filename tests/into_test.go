@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/into"
+	"github.com/workanator/bynom/span"
+)
+
+func TestInto_Uint16(t *testing.T) {
+	var (
+		port uint16
+		r    = NewBite(
+			Expect('='),
+			Take(into.Uint16(&port, 10), WhileAcceptable(span.Range('0', '9'))),
+		)
+	)
+
+	if err := r.Eat(context.Background(), dish.NewString("=8080")); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if port != 8080 {
+		t.Fatalf("Expected port 8080, have %d\n", port)
+	}
+
+	var err = r.Eat(context.Background(), dish.NewString("=70000"))
+	var e into.ErrConversionFailed
+	if !errors.As(err, &e) || !errors.Is(err, strconv.ErrRange) {
+		t.Fatalf("Expected range conversion error, have %v\n", err)
+	}
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "Take" {
+		t.Fatalf("Expected Take in stack, have %v\n", err)
+	}
+}
+
+func TestInto_Interner(t *testing.T) {
+	var (
+		f        = dish.NewFrames(strings.NewReader("a=1\nb=2\na=3\nb=4"), '\n')
+		interner = into.NewInterner(0)
+		key      string
+		values   [][]byte
+		r        = NewBite(
+			Take(interner.String(&key), WhileNot('=')),
+			Expect('='),
+			Take(into.AppendBytes(&values), Any()),
+		)
+	)
+
+	if err := Feast(context.Background(), f, r.Eat, nil); err != nil {
+		t.Fatalf("Failed to feast: %v\n", err)
+	}
+	if interner.Len() != 2 {
+		t.Fatalf("Expected 2 interned keys, have %d\n", interner.Len())
+	}
+	if string(bytes.Join(values, nil)) != "1234" {
+		t.Fatalf("Expected values 1234, have %s\n", bytes.Join(values, nil))
+	}
+}
+
+func TestInto_CopyRetained(t *testing.T) {
+	var (
+		buf             = []byte("key=value")
+		aliased, copied []byte
+		str             string
+		r               = NewBite(
+			Take(into.Bytes(&aliased), WhileNot('=')),
+			Take(into.CopyBytes(&copied), Expect('=')),
+			Take(into.String(&str), Any()),
+		)
+	)
+
+	if err := r.Eat(context.Background(), dish.NewBytes(buf)); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	copy(buf, "XXXXXXXXX")
+
+	if string(aliased) != "XXX" {
+		t.Fatalf("Expected Bytes to alias the buffer, have %s\n", aliased)
+	}
+	if string(copied) != "=" {
+		t.Fatalf("Expected CopyBytes to retain =, have %s\n", copied)
+	}
+	if str != "value" {
+		t.Fatalf("Expected String to retain value, have %s\n", str)
+	}
+}
+
+func TestInto_AppendBytesRetained(t *testing.T) {
+	var (
+		buf    = []byte("ab")
+		values [][]byte
+		r      = NewBite(Many0(Take(into.AppendBytes(&values), ExpectAcceptable(span.Range('a', 'z')))))
+	)
+
+	if err := r.Eat(context.Background(), dish.NewBytes(buf)); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	copy(buf, "XX")
+
+	if string(bytes.Join(values, nil)) != "ab" {
+		t.Fatalf("Expected AppendBytes to retain ab, have %s\n", bytes.Join(values, nil))
+	}
+}
+
+func TestInto_Bool(t *testing.T) {
+	var (
+		v bool
+		r = NewBite(Take(into.Bool(&v), Any()))
+	)
+
+	if err := r.Eat(context.Background(), dish.NewString("true")); err != nil || !v {
+		t.Fatalf("Expected true, have %v, %v\n", v, err)
+	}
+
+	var e into.ErrConversionFailed
+	if err := r.Eat(context.Background(), dish.NewString("yes")); !errors.As(err, &e) || e.Type != "bool" {
+		t.Fatalf("Expected bool conversion error, have %v\n", err)
+	}
+}
+
+func TestInto_Float(t *testing.T) {
+	var (
+		f32 float32
+		f64 float64
+	)
+
+	if err := NewBite(Take(into.Float32(&f32), Any())).Eat(context.Background(), dish.NewString("1.5")); err != nil || f32 != 1.5 {
+		t.Fatalf("Expected 1.5, have %v, %v\n", f32, err)
+	}
+	if err := NewBite(Take(into.Float64(&f64), Any())).Eat(context.Background(), dish.NewString("-2.25e3")); err != nil || f64 != -2250 {
+		t.Fatalf("Expected -2250, have %v, %v\n", f64, err)
+	}
+
+	var err = NewBite(Take(into.Float32(&f32), Any())).Eat(context.Background(), dish.NewString("1e40"))
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Fatalf("Expected range conversion error, have %v\n", err)
+	}
+	err = NewBite(Take(into.Float64(&f64), Any())).Eat(context.Background(), dish.NewString("x"))
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("Expected syntax conversion error, have %v\n", err)
+	}
+}
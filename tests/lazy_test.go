@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+)
+
+func TestLazy_Eat(t *testing.T) {
+	// group = '(' [group] ')'
+	var group Nom
+	group = Sequence(
+		Expect('('),
+		Optional(Lazy(func() Nom { return group })),
+		Expect(')'),
+	)
+
+	var r = NewBite(group)
+	if err := r.Eat(context.Background(), dish.NewString("((()))")); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+
+	var err = r.Eat(WithMaxDepth(context.Background(), 3), dish.NewString("(((())))"))
+	var e ErrMaxDepthExceeded
+	if !errors.As(err, &e) || e.MaxDepth != 3 {
+		t.Fatalf("Expected ErrMaxDepthExceeded, have %v\n", err)
+	}
+}
+
+func TestRef_Eat(t *testing.T) {
+	// list = '[' {item} ']', item = 'x' | list
+	var (
+		list = NewRef("list")
+		item = Switch(Expect('x'), list.Nom())
+	)
+	list.Set(Delimited(Expect('['), Many0(item), Expect(']')))
+
+	if err := NewBite(list.Nom()).Eat(context.Background(), dish.NewString("[x[x[]]x]")); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+}
+
+func TestLazy_DepthReleased(t *testing.T) {
+	// groups = {'(' [group] ')'}
+	var group Nom
+	group = Sequence(
+		Expect('('),
+		Optional(Lazy(func() Nom { return group })),
+		Expect(')'),
+	)
+
+	var r = NewBite(Many0(Lazy(func() Nom { return group })))
+	if err := r.Eat(WithMaxDepth(context.Background(), 3), dish.NewString("(())(())(())")); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+}
+
+func TestLazy_NestedBite(t *testing.T) {
+	// group = '(' [group] ')', where the nested group is parsed by its own Bite
+	var group Nom
+	group = Sequence(
+		Expect('('),
+		Optional(Lazy(func() Nom { return NewBite(group).Eat })),
+		Expect(')'),
+	)
+
+	var (
+		input = strings.Repeat("(", 10) + strings.Repeat(")", 10)
+		err   = NewBite(group).Eat(WithMaxDepth(context.Background(), 3), dish.NewString(input))
+		e     ErrMaxDepthExceeded
+	)
+	if !errors.As(err, &e) || e.MaxDepth != 3 {
+		t.Fatalf("Expected ErrMaxDepthExceeded, have %v\n", err)
+	}
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+)
+
+func TestLimit(t *testing.T) {
+	var (
+		head []byte
+		take = func(b []byte) error { head = b; return nil }
+		r    = NewBite(Take(take, Limit(3, While('a'))), Expect('a'))
+		p    = dish.NewBytes([]byte("aaaa"))
+	)
+
+	if err := r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if !bytes.Equal(head, []byte("aaa")) {
+		t.Fatalf("Expected aaa, have %q\n", head)
+	}
+
+	var err = NewBite(Limit(1, Expect('a'), Expect('a'))).Eat(context.Background(), dish.NewBytes([]byte("aa")))
+	if !errors.Is(err, io.EOF) {
+		t.Fatalf("Expected io.EOF, have %v\n", err)
+	}
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "Limit" || pf.Stack[0].Index != 1 {
+		t.Fatalf("Expected parser 1 of Limit to fail, have %v\n", err)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	var (
+		ctx = context.Background()
+		p   = dish.NewWindow(dish.NewBytes([]byte("0123456789")), 2, 5)
+	)
+
+	if pos, _ := p.TellPosition(ctx); pos != 2 {
+		t.Fatalf("Expected position 2, have %d\n", pos)
+	}
+
+	var got []byte
+	for {
+		b, err := p.NextByte(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Failed to read: %v\n", err)
+		}
+		got = append(got, b)
+	}
+	if string(got) != "234" {
+		t.Fatalf("Expected 234, have %q\n", got)
+	}
+
+	if err := p.SeekPosition(ctx, 6); err == nil {
+		t.Fatalf("Expected seek beyond the window to fail\n")
+	}
+	if err := p.SeekPosition(ctx, 1); err == nil {
+		t.Fatalf("Expected seek before the window to fail\n")
+	}
+	if _, err := p.ByteSlice(ctx, 2, 6); err == nil {
+		t.Fatalf("Expected slice crossing the window to fail\n")
+	}
+
+	if err := p.SeekPosition(ctx, 3); err != nil {
+		t.Fatalf("Failed to seek: %v\n", err)
+	}
+	if b, _ := p.PeekByte(ctx); b != '3' {
+		t.Fatalf("Expected 3, have %q\n", b)
+	}
+}
+
+func TestLimit_Bounds(t *testing.T) {
+	var (
+		seekBack = func(ctx context.Context, p Plate) error {
+			return p.SeekPosition(ctx, 0)
+		}
+		sliceBack = func(ctx context.Context, p Plate) error {
+			_, err := p.ByteSlice(ctx, 0, 2)
+			return err
+		}
+	)
+
+	for name, nom := range map[string]Nom{"SeekPosition": seekBack, "ByteSlice": sliceBack} {
+		var err = NewBite(Expect('a'), Limit(2, nom)).Eat(context.Background(), dish.NewBytes([]byte("abc")))
+		if err == nil {
+			t.Fatalf("%s: expected access before the limit start to fail\n", name)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/span"
+	"github.com/workanator/bynom/state"
+)
+
+func TestLookahead_Eat(t *testing.T) {
+	const (
+		keyword = iota + 1
+		identifier
+	)
+
+	var (
+		ctx    = context.Background()
+		letter = span.Range('a', 'z')
+		kind   = state.NewBits()
+		r      = NewBite(
+			Peek(ExpectAcceptable(letter)),
+			Switch(
+				Sequence(
+					ExpectAcceptable(span.Sample([]byte("if"))),
+					Not(ExpectAcceptable(letter)),
+					ChangeState(keyword, kind.Replace),
+				),
+				Sequence(
+					WhileAcceptable(letter),
+					ChangeState(identifier, kind.Replace),
+				),
+			),
+		)
+	)
+
+	for pattern, expected := range map[string]int64{"if x": keyword, "if": keyword, "iffy": identifier} {
+		if err := r.Eat(ctx, dish.NewString(pattern)); err != nil {
+			t.Fatalf("Failed to eat %s: %v\n", pattern, err)
+		}
+		if !kind.Equal(expected) {
+			t.Fatalf("Expected kind %d of %s, have %d\n", expected, pattern, kind.Int64())
+		}
+	}
+
+	if err := r.Eat(ctx, dish.NewString("1f")); err == nil {
+		t.Fatal("Expected peek failure")
+	}
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/into"
+	"github.com/workanator/bynom/span"
+)
+
+func TestMany_Eat(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		words [][]byte
+		word  = Take(into.AppendBytes(&words), WhileAcceptable(span.Range('a', 'z')))
+	)
+
+	if err := NewBite(Many0(word, Expect(';'))).Eat(ctx, dish.NewString("ab;cd;ef;")); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if len(words) != 3 {
+		t.Fatalf("Expected 3 words, have %d\n", len(words))
+	}
+
+	words = nil
+	var p = dish.NewString("ab;cd;ef;gh;")
+	if err := NewBite(ManyMN(2, 3, word, Expect(';'))).Eat(ctx, p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if pos, _ := p.TellPosition(ctx); pos != 9 {
+		t.Fatalf("Expected position 9, have %d\n", pos)
+	}
+
+	var err = NewBite(Many1(word)).Eat(ctx, dish.NewString("12"))
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "Many1" || pf.Stack[0].Index != 0 {
+		t.Fatalf("Expected Many1 failure at iteration 0, have %v\n", err)
+	}
+
+	err = NewBite(Many0(Optional(Expect('x')))).Eat(ctx, dish.NewString("ab"))
+	if !errors.Is(err, ErrNoProgress) {
+		t.Fatalf("Expected ErrNoProgress, have %v\n", err)
+	}
+}
+
+func TestManyMN_MinGreaterMax(t *testing.T) {
+	var (
+		p   = dish.NewString("aaa")
+		err = NewBite(ManyMN(3, 1, Expect('a'))).Eat(context.Background(), p)
+	)
+
+	var rnm ErrRequirementNotMet
+	if !errors.As(err, &rnm) || rnm.Expected != 3 || rnm.Have != 1 {
+		t.Fatalf("Expected ErrRequirementNotMet, have %v\n", err)
+	}
+	if pos, _ := p.TellPosition(context.Background()); pos != 0 {
+		t.Fatalf("Expected position 0, have %d\n", pos)
+	}
+}
@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/span"
+)
+
+func TestMemo_Eat(t *testing.T) {
+	var (
+		runs   int
+		digits = WhileAcceptable(span.Range('0', '9'))
+		number = Memo(func(ctx context.Context, p Plate) error {
+			runs++
+			return digits(ctx, p)
+		})
+		r = NewBite(
+			Switch(
+				Sequence(number, Expect(':'), number),
+				Sequence(number, Expect('.'), number),
+				Sequence(number, Expect('/'), number),
+			),
+		)
+	)
+
+	if err := r.Eat(context.Background(), dish.NewString("12/34")); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if runs != 2 {
+		t.Fatalf("Expected 2 runs, have %d\n", runs)
+	}
+
+	runs = 0
+	if err := r.Eat(context.Background(), dish.NewString("12/34")); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if runs != 2 {
+		t.Fatalf("Expected cache to be scoped to Eat, have %d runs\n", runs)
+	}
+}
+
+func TestMemo_Limit(t *testing.T) {
+	var (
+		number = Memo(WhileAcceptable(span.Range('0', '9')))
+		r      = NewBite(
+			Switch(
+				Sequence(Limit(2, number), Expect(':')),
+				Sequence(number, Expect('.')),
+			),
+		)
+	)
+
+	if err := r.Eat(context.Background(), dish.NewString("1234.")); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+}
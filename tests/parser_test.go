@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/span"
+)
+
+func TestParser_Parse(t *testing.T) {
+	type clock struct {
+		hour, minute int
+	}
+
+	var (
+		number = Map(Lift(RequireLen(2, WhileAcceptable(span.Range('0', '9')))), func(b []byte) (int, error) {
+			return strconv.Atoi(string(b))
+		})
+		colon = Lift(Expect(':'))
+		time  = Map(Pair(number, Then(colon, func([]byte) Parser[int] { return number })), func(v PairOf[int, int]) (clock, error) {
+			return clock{hour: v.First, minute: v.Second}, nil
+		})
+	)
+
+	var v, err = Parse(context.Background(), dish.NewString("12:34"), time)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v\n", err)
+	}
+	if v.hour != 12 || v.minute != 34 {
+		t.Fatalf("Expected 12:34, have %d:%d\n", v.hour, v.minute)
+	}
+
+	if _, err = Parse(context.Background(), dish.NewString("12-34"), time); err == nil {
+		t.Fatal("Expected parse error")
+	}
+}
+
+func TestLift_Empty(t *testing.T) {
+	var v, err = Parse(context.Background(), dish.NewString("y"), Lift(Optional(Expect('x'))))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v\n", err)
+	}
+	if v != nil {
+		t.Fatalf("Expected nil for the empty match, have %q\n", v)
+	}
+}
+
+func TestMap_Error(t *testing.T) {
+	var (
+		errOdd = errors.New("odd number")
+		number = Map(Lift(WhileAcceptable(span.Range('0', '9'))), func(b []byte) (int, error) {
+			var n, err = strconv.Atoi(string(b))
+			if err == nil && n%2 != 0 {
+				return 0, errOdd
+			}
+			return n, err
+		})
+		_, err = Parse(context.Background(), dish.NewString("13"), number)
+	)
+
+	if !errors.Is(err, errOdd) {
+		t.Fatalf("Expected the error of fn, have %v\n", err)
+	}
+	var pf, ok = err.(*ErrParseFailed)
+	if !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "Map" || pf.Stack[0].StartPos != 0 || pf.Stack[0].EndPos != 2 {
+		t.Fatalf("Expected Map breadcrumb at positions 0:2, have %v\n", err)
+	}
+}
+
+func TestThen_Error(t *testing.T) {
+	var (
+		length = Map(Lift(ExpectAcceptable(span.Range('0', '9'))), func(b []byte) (int, error) {
+			return int(b[0] - '0'), nil
+		})
+		body = Then(length, func(n int) Parser[[]byte] {
+			return Lift(RequireLen(n, WhileAcceptable(span.Range('a', 'z'))))
+		})
+	)
+
+	if v, err := Parse(context.Background(), dish.NewString("3abc"), body); err != nil || string(v) != "abc" {
+		t.Fatalf("Expected abc, have %q, %v\n", v, err)
+	}
+
+	var _, err = Parse(context.Background(), dish.NewString("3ab"), body)
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "Then" || pf.Stack[0].Index != 1 {
+		t.Fatalf("Expected the second step of Then to fail, have %v\n", err)
+	}
+	_, err = Parse(context.Background(), dish.NewString("x"), body)
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "Then" || pf.Stack[0].Index != 0 {
+		t.Fatalf("Expected the first step of Then to fail, have %v\n", err)
+	}
+}
+
+func TestPair_Error(t *testing.T) {
+	var pair = Pair(Lift(Expect('a')), Lift(Expect('b')))
+
+	if v, err := Parse(context.Background(), dish.NewString("ab"), pair); err != nil || string(v.First) != "a" || string(v.Second) != "b" {
+		t.Fatalf("Expected a and b, have %q %q, %v\n", v.First, v.Second, err)
+	}
+
+	for input, index := range map[string]int{"xb": 0, "ax": 1} {
+		var _, err = Parse(context.Background(), dish.NewString(input), pair)
+		if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "Pair" || pf.Stack[0].Index != index {
+			t.Fatalf("%s: expected parser %d of Pair to fail, have %v\n", input, index, err)
+		}
+	}
+}
+
+func TestParse_Rollback(t *testing.T) {
+	var (
+		p      = dish.NewString("abc")
+		_, err = Parse(context.Background(), p, Pair(Lift(Expect('a')), Lift(Expect('x'))))
+	)
+
+	if err == nil {
+		t.Fatal("Expected parse error")
+	}
+	if pos, _ := p.TellPosition(context.Background()); pos != 0 {
+		t.Fatalf("Expected the read position reverted to 0, have %d\n", pos)
+	}
+}
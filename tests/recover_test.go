@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/prettierr"
+	"github.com/workanator/bynom/span"
+)
+
+func TestRecover_Eat(t *testing.T) {
+	var (
+		statement = Sequence(
+			WhileAcceptable(span.Range('a', 'z')),
+			Expect('='),
+			WhileAcceptable(span.Range('0', '9')),
+			Expect(';'),
+		)
+		r = NewBite(
+			Many0(Recover(statement, Expect(';'))),
+		)
+		p = dish.NewLines(dish.NewString("a=1;b=x;c=3;d 4;e=5;"))
+	)
+
+	var err = r.Eat(context.Background(), p)
+	var e, ok = err.(*ErrParseFailures)
+	if !ok {
+		t.Fatalf("Expected ErrParseFailures, have %v\n", err)
+	}
+	if len(e.Errs) != 2 {
+		t.Fatalf("Expected 2 failures, have %d: %v\n", len(e.Errs), err)
+	}
+	if pf, ok := e.Errs[1].(*ErrParseFailed); !ok || pf.StartPos != 12 {
+		t.Fatalf("Expected second failure start at position 12, have %v\n", e.Errs[1])
+	}
+
+	var (
+		buf       bytes.Buffer
+		formatter prettierr.TextFormatter
+	)
+	if err = formatter.Format(&buf, err); err != nil {
+		t.Fatalf("Failed to format: %v\n", err)
+	}
+	if strings.Count(buf.String(), "Error:") != 2 {
+		t.Fatalf("Expected 2 formatted failures, have %s\n", buf.String())
+	}
+}
+
+func TestRecover_Backtracked(t *testing.T) {
+	var r = NewBite(
+		Optional(Recover(Sequence(Expect('a'), Expect(';')), Expect(';')), Expect('!')),
+		Any(),
+	)
+
+	if err := r.Eat(context.Background(), dish.NewString("b;c")); err != nil {
+		t.Fatalf("Expected failures on the abandoned branch to be dropped, have %v\n", err)
+	}
+}
+
+func TestRecover_Unwrap(t *testing.T) {
+	var (
+		r   = NewBite(Many0(Recover(Sequence(Expect('a'), Expect(';')), Expect(';'))))
+		err = r.Eat(context.Background(), dish.NewString("a;b;"))
+	)
+
+	if _, ok := err.(*ErrParseFailures); !ok {
+		t.Fatalf("Expected ErrParseFailures, have %v\n", err)
+	}
+	if !errors.Is(err, ErrExpectationFailed{Expected: byte('a'), Have: 'b'}) {
+		t.Fatalf("Expected ErrExpectationFailed behind ErrParseFailures, have %v\n", err)
+	}
+}
+
+func TestRecover_Cut(t *testing.T) {
+	var (
+		digit = ExpectAcceptable(span.Range('0', '9'))
+		stmt  = Switch(
+			Sequence(Expect('{'), Cut(digit, Expect('}'))),
+			Sequence(Expect('['), Cut(digit, Expect(']'))),
+		)
+		r   = NewBite(Many0(Recover(Sequence(stmt, Expect(';')), Expect(';'))))
+		err = r.Eat(context.Background(), dish.NewString("{1};{x};{2};[3};[4];"))
+	)
+
+	var e, ok = err.(*ErrParseFailures)
+	if !ok {
+		t.Fatalf("Expected ErrParseFailures, have %v\n", err)
+	}
+	if len(e.Errs) != 2 {
+		t.Fatalf("Expected 2 failures, have %d: %v\n", len(e.Errs), err)
+	}
+	if pf, ok := e.Errs[1].(*ErrParseFailed); !ok || pf.StartPos != 12 {
+		t.Fatalf("Expected second failure start at position 12, have %v\n", e.Errs[1])
+	}
+}
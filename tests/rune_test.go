@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"unicode"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/into"
+	"github.com/workanator/bynom/span"
+)
+
+func TestRune_Eat(t *testing.T) {
+	var (
+		p             = dish.NewString("héllo→wörld")
+		first, second []byte
+		r             = NewBite(
+			Take(into.Bytes(&first), WhileAcceptable(span.Unicode(unicode.Letter))),
+			ExpectRune('→'),
+			Take(into.Bytes(&second), WhileRune(unicode.IsLetter)),
+		)
+	)
+
+	if err := r.Eat(context.Background(), p); err != nil {
+		t.Fatalf("Failed to eat: %v\n", err)
+	}
+	if string(first) != "héllo" {
+		t.Fatalf("Expected first %s, have %s\n", "héllo", string(first))
+	}
+	if string(second) != "wörld" {
+		t.Fatalf("Expected second %s, have %s\n", "wörld", string(second))
+	}
+}
+
+func TestRune_InvalidUTF8(t *testing.T) {
+	var (
+		p = dish.NewBytes([]byte{'a', 0xC3, 'b'})
+		r = NewBite(WhileRune(unicode.IsLetter))
+		e ErrInvalidUTF8
+	)
+
+	var err = r.Eat(context.Background(), p)
+	if !errors.As(err, &e) {
+		t.Fatalf("Expected ErrInvalidUTF8, have %v\n", err)
+	}
+	if e.Pos != 1 {
+		t.Fatalf("Expected position 1, have %d\n", e.Pos)
+	}
+}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/into"
+	"github.com/workanator/bynom/span"
+)
+
+func TestSeparatedList_Eat(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		items [][]byte
+		item  = Take(into.AppendBytes(&items), WhileAcceptable(span.Range('a', 'z')))
+		list  = func(trailing Trailing) *Bite {
+			return NewBite(Delimited(Expect('['), SeparatedList0(Expect(','), item, trailing), Expect(']')))
+		}
+	)
+
+	for _, pattern := range []string{"[]", "[a]", "[a,b]", "[a,b,]"} {
+		items = nil
+		if err := list(TrailingAllow).Eat(ctx, dish.NewString(pattern)); err != nil {
+			t.Fatalf("Failed to eat %s: %v\n", pattern, err)
+		}
+	}
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 items, have %d\n", len(items))
+	}
+
+	if err := list(TrailingNone).Eat(ctx, dish.NewString("[a,b,]")); err == nil {
+		t.Fatal("Expected trailing separator failure")
+	}
+
+	var err = NewBite(SeparatedList1(Expect(','), item, TrailingRequire)).Eat(ctx, dish.NewString("a,b"))
+	if pf, ok := err.(*ErrParseFailed); !ok || len(pf.Stack) == 0 || pf.Stack[0].Name != "SeparatedList1" || pf.Stack[0].Index != 1 {
+		t.Fatalf("Expected SeparatedList1 failure at item 1, have %v\n", err)
+	}
+}
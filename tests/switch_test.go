@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+	"github.com/workanator/bynom/into"
+	"github.com/workanator/bynom/prettierr"
+	"github.com/workanator/bynom/span"
+)
+
+func TestSwitch_ExpectedOneOf(t *testing.T) {
+	var (
+		digits = RequireLen(2, WhileAcceptable(span.Range('0', '9')))
+		r      = NewBite(
+			Switch(
+				Sequence(Expect('x')),
+				Sequence(digits, Expect(':'), digits),
+				Sequence(digits, Expect('.'), digits),
+				Sequence(digits, Expect('/'), digits),
+			),
+		)
+	)
+
+	var err = r.Eat(context.Background(), dish.NewString("12-34"))
+	var e, ok = err.(*ErrParseFailed)
+	if !ok {
+		t.Fatalf("Expected ErrParseFailed, have %v\n", err)
+	}
+	if oneOf, ok := e.Err.(ErrExpectedOneOf); !ok || strings.Join(oneOf.Expected, " ") != "':' '.' '/'" {
+		t.Fatalf("Expected one of ':' '.' '/', have %v\n", e.Err)
+	}
+	if e.EndPos != 3 {
+		t.Fatalf("Expected end position 3, have %d\n", e.EndPos)
+	}
+
+	var (
+		buf       bytes.Buffer
+		formatter prettierr.TextFormatter
+	)
+	if err = formatter.Format(&buf, e); err != nil {
+		t.Fatalf("Failed to format: %v\n", err)
+	}
+	if !strings.Contains(buf.String(), "expected one of ':', '.', '/'") {
+		t.Fatalf("Expected formatted alternatives, have %s\n", buf.String())
+	}
+}
+
+func TestSwitch_NotExpectation(t *testing.T) {
+	var (
+		n      int
+		prefix = Sequence(Expect('1'), Expect('x'))
+		r      = NewBite(
+			Switch(
+				Sequence(prefix, Expect('!')),
+				Take(into.Int(&n, 10), prefix, Expect('.')),
+				Sequence(prefix, Expect('?')),
+			),
+		)
+	)
+
+	var err = r.Eat(context.Background(), dish.NewString("1x."))
+	if !errors.As(err, &into.ErrConversionFailed{}) {
+		t.Fatalf("Expected ErrConversionFailed, have %v\n", err)
+	}
+
+	err = r.Eat(context.Background(), dish.NewString("1x-"))
+	var oneOf ErrExpectedOneOf
+	if !errors.As(err, &oneOf) || len(oneOf.Errs) != 3 {
+		t.Fatalf("Expected ErrExpectedOneOf, have %v\n", err)
+	}
+	if !errors.As(err, &ErrExpectationFailed{}) {
+		t.Fatalf("Expected ErrExpectationFailed behind ErrExpectedOneOf, have %v\n", err)
+	}
+}
+
+func TestSwitch_MixedConsumption(t *testing.T) {
+	var digits = WhileAcceptable(span.Range('0', '9'))
+
+	var err = NewBite(Switch(Sequence(Expect('a'), digits), Expect('c'))).Eat(context.Background(), dish.NewString("ax"))
+	var e ErrExpectationFailed
+	if !errors.As(err, &e) || e.Expected == byte('c') {
+		t.Fatalf("Expected only [0-9] to be expected, have %v\n", err)
+	}
+	if errors.As(err, &ErrExpectedOneOf{}) {
+		t.Fatalf("Expected expectations at different positions not to be merged, have %v\n", err)
+	}
+
+	err = NewBite(Switch(Sequence(Expect('a'), digits), Sequence(Expect('a'), Expect('b')))).Eat(context.Background(), dish.NewString("ax"))
+	var oneOf ErrExpectedOneOf
+	if !errors.As(err, &oneOf) || strings.Join(oneOf.Expected, " ") != "[0-9] 'b'" || oneOf.Pos != 1 {
+		t.Fatalf("Expected one of [0-9] 'b' at position 1, have %v\n", err)
+	}
+}
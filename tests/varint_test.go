@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/workanator/bynom"
+	"github.com/workanator/bynom/dish"
+)
+
+func TestVarint_Eat(t *testing.T) {
+	var (
+		ctx = context.Background()
+		buf [binary.MaxVarintLen64]byte
+		u   uint64
+		i   int64
+	)
+
+	for _, expected := range []uint64{0, 1, 127, 128, 300, math.MaxUint32, math.MaxUint64} {
+		var n = binary.PutUvarint(buf[:], expected)
+		if err := NewBite(Uvarint(&u)).Eat(ctx, dish.NewBytes(buf[:n])); err != nil || u != expected {
+			t.Fatalf("Expected uvarint %d, have %d: %v\n", expected, u, err)
+		}
+	}
+	for _, expected := range []int64{0, -1, 1, -64, 64, math.MinInt64, math.MaxInt64} {
+		var n = binary.PutVarint(buf[:], expected)
+		if err := NewBite(Varint(&i)).Eat(ctx, dish.NewBytes(buf[:n])); err != nil || i != expected {
+			t.Fatalf("Expected varint %d, have %d: %v\n", expected, i, err)
+		}
+	}
+
+	for expected, encoded := range map[int64][]byte{
+		2:       {0x02},
+		-1:      {0x7F},
+		127:     {0xFF, 0x00},
+		-128:    {0x80, 0x7F},
+		-123456: {0xC0, 0xBB, 0x78},
+	} {
+		if err := NewBite(SLEB128(&i)).Eat(ctx, dish.NewBytes(encoded)); err != nil || i != expected {
+			t.Fatalf("Expected SLEB128 %d, have %d: %v\n", expected, i, err)
+		}
+	}
+
+	var (
+		overlong ErrVarintOverlong
+		overflow ErrVarintOverflow
+	)
+	if err := NewBite(ULEB128(&u)).Eat(ctx, dish.NewBytes([]byte{0x80, 0x00})); !errors.As(err, &overlong) {
+		t.Fatalf("Expected ErrVarintOverlong, have %v\n", err)
+	}
+	if err := NewBite(SLEB128(&i)).Eat(ctx, dish.NewBytes([]byte{0xFF, 0x7F})); !errors.As(err, &overlong) {
+		t.Fatalf("Expected ErrVarintOverlong, have %v\n", err)
+	}
+	var tooLong = []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x02}
+	if err := NewBite(Expect('x'), Uvarint(&u)).Eat(ctx, dish.NewBytes(append([]byte{'x'}, tooLong...))); !errors.As(err, &overflow) || overflow.Pos != 1 {
+		t.Fatalf("Expected ErrVarintOverflow at position 1, have %v\n", err)
+	}
+}
@@ -0,0 +1,132 @@
+package bynom
+
+import (
+	"context"
+	"io"
+)
+
+// maxVarintLen is the maximum length of 64-bit integer in LEB128 encoding.
+const maxVarintLen = 10
+
+// Uvarint reads the unsigned protobuf varint from the plate and assigns it to the variable p.
+// If the integer does not fit into 64 bits the function fails with ErrVarintOverflow.
+// If the integer is encoded with more bytes than necessary the function fails with ErrVarintOverlong.
+// If the plate ends in the middle of the integer the function fails with io.ErrUnexpectedEOF.
+func Uvarint(p *uint64) Nom {
+	return varint("Uvarint", func(ctx context.Context, pl Plate, startPos int) (err error) {
+		*p, err = readUvarint(ctx, pl, startPos)
+		return
+	})
+}
+
+// Varint reads the signed zigzag-encoded protobuf varint from the plate and assigns it to the variable p.
+// See Uvarint for errors.
+func Varint(p *int64) Nom {
+	return varint("Varint", func(ctx context.Context, pl Plate, startPos int) (err error) {
+		var u uint64
+		if u, err = readUvarint(ctx, pl, startPos); err != nil {
+			return
+		}
+
+		*p = int64(u>>1) ^ -int64(u&1)
+		return
+	})
+}
+
+// ULEB128 reads the unsigned LEB128 integer from the plate and assigns it to the variable p.
+// See Uvarint for errors.
+func ULEB128(p *uint64) Nom {
+	return varint("ULEB128", func(ctx context.Context, pl Plate, startPos int) (err error) {
+		*p, err = readUvarint(ctx, pl, startPos)
+		return
+	})
+}
+
+// SLEB128 reads the signed LEB128 integer from the plate and assigns it to the variable p.
+// See Uvarint for errors.
+func SLEB128(p *int64) Nom {
+	return varint("SLEB128", func(ctx context.Context, pl Plate, startPos int) (err error) {
+		*p, err = readSLEB128(ctx, pl, startPos)
+		return
+	})
+}
+
+func varint(funcName string, fn func(context.Context, Plate, int) error) Nom {
+	return func(ctx context.Context, p Plate) (err error) {
+		var startPos int
+		if startPos, err = p.TellPosition(ctx); err != nil {
+			return WrapBreadcrumb(err, funcName, -1)
+		}
+
+		if err = fn(ctx, p, startPos); err != nil {
+			var errPos, _ = p.TellPosition(ctx)
+			return ExtendBreadcrumb(WrapBreadcrumb(err, funcName, -1), startPos, errPos)
+		}
+
+		return
+	}
+}
+
+// readUvarint reads the unsigned LEB128 integer which starts at the position startPos.
+func readUvarint(ctx context.Context, p Plate, startPos int) (v uint64, err error) {
+	var (
+		b     byte
+		shift uint
+	)
+	for n := 0; n < maxVarintLen; n++ {
+		if b, err = p.NextByte(ctx); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		if n == maxVarintLen-1 && b > 1 {
+			break
+		}
+
+		v |= uint64(b&0x7F) << shift
+		if b < 0x80 {
+			if b == 0 && n > 0 {
+				return 0, ErrVarintOverlong{Pos: startPos}
+			}
+			return
+		}
+		shift += 7
+	}
+
+	return 0, ErrVarintOverflow{Pos: startPos}
+}
+
+// readSLEB128 reads the signed LEB128 integer which starts at the position startPos.
+func readSLEB128(ctx context.Context, p Plate, startPos int) (v int64, err error) {
+	var (
+		b, prev byte
+		shift   uint
+	)
+	for n := 0; n < maxVarintLen; n++ {
+		if b, err = p.NextByte(ctx); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		if n == maxVarintLen-1 && b != 0x00 && b != 0x7F {
+			break
+		}
+
+		v |= int64(b&0x7F) << shift
+		shift += 7
+		if b < 0x80 {
+			if n > 0 && (b == 0x00 && prev&0x40 == 0 || b == 0x7F && prev&0x40 != 0) {
+				return 0, ErrVarintOverlong{Pos: startPos}
+			}
+			if shift < 64 && b&0x40 != 0 {
+				v |= -1 << shift
+			}
+			return
+		}
+		prev = b
+	}
+
+	return 0, ErrVarintOverflow{Pos: startPos}
+}
@@ -228,3 +228,52 @@ func WhileIneligible(r Relevance) Nom {
 		return
 	}
 }
+
+// WhileRune reads UTF-8 encoded runes from the plate while they are accepted by fn.
+// The function reads while the condition met or io.EOF encountered. The function does not propagate io.EOF.
+// The function expects to read at least one rune which meets the condition, otherwise it returns io.ErrUnexpectedEOF.
+// If the bytes read are not valid UTF-8 the function will return ErrInvalidUTF8.
+func WhileRune(fn func(rune) bool) Nom {
+	const funcName = "WhileRune"
+
+	return func(ctx context.Context, p Plate) (err error) {
+		var (
+			count int
+			r     rune
+			size  int
+		)
+		for {
+			if r, size, err = PeekRune(ctx, p); err != nil {
+				if err == io.EOF {
+					if count > 0 {
+						return nil
+					}
+					err = io.ErrUnexpectedEOF
+				}
+				return WrapBreadcrumb(err, funcName, -1)
+			}
+			if !fn(r) {
+				break
+			}
+
+			for i := 0; i < size; i++ {
+				if _, err = p.NextByte(ctx); err != nil {
+					return WrapBreadcrumb(err, funcName, -1)
+				}
+			}
+			count++
+		}
+		if count == 0 {
+			return WrapBreadcrumb(
+				ErrRuneExpectationFailed{
+					Expected: "rune accepted by condition",
+					Have:     r,
+				},
+				funcName,
+				-1,
+			)
+		}
+
+		return
+	}
+}